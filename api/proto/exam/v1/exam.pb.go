@@ -23,6 +23,108 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ExamSortField int32
+
+const (
+	ExamSortField_EXAM_SORT_FIELD_UNSPECIFIED ExamSortField = 0
+	ExamSortField_EXAM_SORT_FIELD_CREATED_AT  ExamSortField = 1
+	// Exams that never started sort as the earliest
+	ExamSortField_EXAM_SORT_FIELD_START_TIME ExamSortField = 2
+	ExamSortField_EXAM_SORT_FIELD_TITLE      ExamSortField = 3
+)
+
+// Enum value maps for ExamSortField.
+var (
+	ExamSortField_name = map[int32]string{
+		0: "EXAM_SORT_FIELD_UNSPECIFIED",
+		1: "EXAM_SORT_FIELD_CREATED_AT",
+		2: "EXAM_SORT_FIELD_START_TIME",
+		3: "EXAM_SORT_FIELD_TITLE",
+	}
+	ExamSortField_value = map[string]int32{
+		"EXAM_SORT_FIELD_UNSPECIFIED": 0,
+		"EXAM_SORT_FIELD_CREATED_AT":  1,
+		"EXAM_SORT_FIELD_START_TIME":  2,
+		"EXAM_SORT_FIELD_TITLE":       3,
+	}
+)
+
+func (x ExamSortField) Enum() *ExamSortField {
+	p := new(ExamSortField)
+	*p = x
+	return p
+}
+
+func (x ExamSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExamSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_exam_v1_exam_proto_enumTypes[0].Descriptor()
+}
+
+func (ExamSortField) Type() protoreflect.EnumType {
+	return &file_api_proto_exam_v1_exam_proto_enumTypes[0]
+}
+
+func (x ExamSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExamSortField.Descriptor instead.
+func (ExamSortField) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_exam_v1_exam_proto_rawDescGZIP(), []int{0}
+}
+
+type SortDirection int32
+
+const (
+	SortDirection_SORT_DIRECTION_UNSPECIFIED SortDirection = 0
+	SortDirection_SORT_DIRECTION_ASC         SortDirection = 1
+	SortDirection_SORT_DIRECTION_DESC        SortDirection = 2
+)
+
+// Enum value maps for SortDirection.
+var (
+	SortDirection_name = map[int32]string{
+		0: "SORT_DIRECTION_UNSPECIFIED",
+		1: "SORT_DIRECTION_ASC",
+		2: "SORT_DIRECTION_DESC",
+	}
+	SortDirection_value = map[string]int32{
+		"SORT_DIRECTION_UNSPECIFIED": 0,
+		"SORT_DIRECTION_ASC":         1,
+		"SORT_DIRECTION_DESC":        2,
+	}
+)
+
+func (x SortDirection) Enum() *SortDirection {
+	p := new(SortDirection)
+	*p = x
+	return p
+}
+
+func (x SortDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_exam_v1_exam_proto_enumTypes[1].Descriptor()
+}
+
+func (SortDirection) Type() protoreflect.EnumType {
+	return &file_api_proto_exam_v1_exam_proto_enumTypes[1]
+}
+
+func (x SortDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortDirection.Descriptor instead.
+func (SortDirection) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_exam_v1_exam_proto_rawDescGZIP(), []int{1}
+}
+
 type ExamState int32
 
 const (
@@ -59,11 +161,11 @@ func (x ExamState) String() string {
 }
 
 func (ExamState) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_proto_exam_v1_exam_proto_enumTypes[0].Descriptor()
+	return file_api_proto_exam_v1_exam_proto_enumTypes[2].Descriptor()
 }
 
 func (ExamState) Type() protoreflect.EnumType {
-	return &file_api_proto_exam_v1_exam_proto_enumTypes[0]
+	return &file_api_proto_exam_v1_exam_proto_enumTypes[2]
 }
 
 func (x ExamState) Number() protoreflect.EnumNumber {
@@ -72,7 +174,111 @@ func (x ExamState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ExamState.Descriptor instead.
 func (ExamState) EnumDescriptor() ([]byte, []int) {
-	return file_api_proto_exam_v1_exam_proto_rawDescGZIP(), []int{0}
+	return file_api_proto_exam_v1_exam_proto_rawDescGZIP(), []int{2}
+}
+
+type ScorePolicy int32
+
+const (
+	ScorePolicy_SCORE_POLICY_UNSPECIFIED ScorePolicy = 0
+	ScorePolicy_SCORE_POLICY_PERCENTAGE  ScorePolicy = 1
+	ScorePolicy_SCORE_POLICY_RAW_POINTS  ScorePolicy = 2
+	ScorePolicy_SCORE_POLICY_SCALED      ScorePolicy = 3
+)
+
+// Enum value maps for ScorePolicy.
+var (
+	ScorePolicy_name = map[int32]string{
+		0: "SCORE_POLICY_UNSPECIFIED",
+		1: "SCORE_POLICY_PERCENTAGE",
+		2: "SCORE_POLICY_RAW_POINTS",
+		3: "SCORE_POLICY_SCALED",
+	}
+	ScorePolicy_value = map[string]int32{
+		"SCORE_POLICY_UNSPECIFIED": 0,
+		"SCORE_POLICY_PERCENTAGE":  1,
+		"SCORE_POLICY_RAW_POINTS":  2,
+		"SCORE_POLICY_SCALED":      3,
+	}
+)
+
+func (x ScorePolicy) Enum() *ScorePolicy {
+	p := new(ScorePolicy)
+	*p = x
+	return p
+}
+
+func (x ScorePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScorePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_exam_v1_exam_proto_enumTypes[3].Descriptor()
+}
+
+func (ScorePolicy) Type() protoreflect.EnumType {
+	return &file_api_proto_exam_v1_exam_proto_enumTypes[3]
+}
+
+func (x ScorePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScorePolicy.Descriptor instead.
+func (ScorePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_exam_v1_exam_proto_rawDescGZIP(), []int{3}
+}
+
+type PenaltyType int32
+
+const (
+	PenaltyType_PENALTY_TYPE_UNSPECIFIED PenaltyType = 0
+	PenaltyType_PENALTY_TYPE_NONE        PenaltyType = 1
+	PenaltyType_PENALTY_TYPE_FIXED       PenaltyType = 2
+	PenaltyType_PENALTY_TYPE_FRACTION    PenaltyType = 3
+)
+
+// Enum value maps for PenaltyType.
+var (
+	PenaltyType_name = map[int32]string{
+		0: "PENALTY_TYPE_UNSPECIFIED",
+		1: "PENALTY_TYPE_NONE",
+		2: "PENALTY_TYPE_FIXED",
+		3: "PENALTY_TYPE_FRACTION",
+	}
+	PenaltyType_value = map[string]int32{
+		"PENALTY_TYPE_UNSPECIFIED": 0,
+		"PENALTY_TYPE_NONE":        1,
+		"PENALTY_TYPE_FIXED":       2,
+		"PENALTY_TYPE_FRACTION":    3,
+	}
+)
+
+func (x PenaltyType) Enum() *PenaltyType {
+	p := new(PenaltyType)
+	*p = x
+	return p
+}
+
+func (x PenaltyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PenaltyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_exam_v1_exam_proto_enumTypes[4].Descriptor()
+}
+
+func (PenaltyType) Type() protoreflect.EnumType {
+	return &file_api_proto_exam_v1_exam_proto_enumTypes[4]
+}
+
+func (x PenaltyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PenaltyType.Descriptor instead.
+func (PenaltyType) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_exam_v1_exam_proto_rawDescGZIP(), []int{4}
 }
 
 type ExamStudentState int32
@@ -82,6 +288,8 @@ const (
 	ExamStudentState_EXAM_STUDENT_STATE_NOT_STARTED ExamStudentState = 1
 	ExamStudentState_EXAM_STUDENT_STATE_IN_PROGRESS ExamStudentState = 2
 	ExamStudentState_EXAM_STUDENT_STATE_FINISHED    ExamStudentState = 3
+	// The session was closed because time ran out
+	ExamStudentState_EXAM_STUDENT_STATE_TIMED_OUT ExamStudentState = 4
 )
 
 // Enum value maps for ExamStudentState.
@@ -91,12 +299,14 @@ var (
 		1: "EXAM_STUDENT_STATE_NOT_STARTED",
 		2: "EXAM_STUDENT_STATE_IN_PROGRESS",
 		3: "EXAM_STUDENT_STATE_FINISHED",
+		4: "EXAM_STUDENT_STATE_TIMED_OUT",
 	}
 	ExamStudentState_value = map[string]int32{
 		"EXAM_STUDENT_STATE_UNSPECIFIED": 0,
 		"EXAM_STUDENT_STATE_NOT_STARTED": 1,
 		"EXAM_STUDENT_STATE_IN_PROGRESS": 2,
 		"EXAM_STUDENT_STATE_FINISHED":    3,
+		"EXAM_STUDENT_STATE_TIMED_OUT":   4,
 	}
 )
 
@@ -111,11 +321,11 @@ func (x ExamStudentState) String() string {
 }
 
 func (ExamStudentState) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_proto_exam_v1_exam_proto_enumTypes[1].Descriptor()
+	return file_api_proto_exam_v1_exam_proto_enumTypes[5].Descriptor()
 }
 
 func (ExamStudentState) Type() protoreflect.EnumType {
-	return &file_api_proto_exam_v1_exam_proto_enumTypes[1]
+	return &file_api_proto_exam_v1_exam_proto_enumTypes[5]
 }
 
 func (x ExamStudentState) Number() protoreflect.EnumNumber {
@@ -124,26 +334,34 @@ func (x ExamStudentState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ExamStudentState.Descriptor instead.
 func (ExamStudentState) EnumDescriptor() ([]byte, []int) {
-	return file_api_proto_exam_v1_exam_proto_rawDescGZIP(), []int{1}
+	return file_api_proto_exam_v1_exam_proto_rawDescGZIP(), []int{5}
 }
 
 type Exam struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Subject         string                 `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
-	DurationMinutes int32                  `protobuf:"varint,4,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
-	TotalQuestions  int32                  `protobuf:"varint,5,opt,name=total_questions,json=totalQuestions,proto3" json:"total_questions,omitempty"`
-	IsRandom        bool                   `protobuf:"varint,6,opt,name=is_random,json=isRandom,proto3" json:"is_random,omitempty"`
-	TeacherId       string                 `protobuf:"bytes,7,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
-	ClassIds        []string               `protobuf:"bytes,8,rep,name=class_ids,json=classIds,proto3" json:"class_ids,omitempty"`
-	Status          *ExamStatus            `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
-	StartTime       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title              string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Subject            string                 `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	DurationMinutes    int32                  `protobuf:"varint,4,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	TotalQuestions     int32                  `protobuf:"varint,5,opt,name=total_questions,json=totalQuestions,proto3" json:"total_questions,omitempty"`
+	IsRandom           bool                   `protobuf:"varint,6,opt,name=is_random,json=isRandom,proto3" json:"is_random,omitempty"`
+	TeacherId          string                 `protobuf:"bytes,7,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	ClassIds           []string               `protobuf:"bytes,8,rep,name=class_ids,json=classIds,proto3" json:"class_ids,omitempty"`
+	Status             *ExamStatus            `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	StartTime          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime            *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ScheduledStartTime *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=scheduled_start_time,json=scheduledStartTime,proto3" json:"scheduled_start_time,omitempty"`
+	ScheduledEndTime   *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=scheduled_end_time,json=scheduledEndTime,proto3" json:"scheduled_end_time,omitempty"`
+	ShuffleChoices     bool                   `protobuf:"varint,16,opt,name=shuffle_choices,json=shuffleChoices,proto3" json:"shuffle_choices,omitempty"`
+	ScorePolicy        ScorePolicy            `protobuf:"varint,17,opt,name=score_policy,json=scorePolicy,proto3,enum=exam.v1.ScorePolicy" json:"score_policy,omitempty"`
+	ScoreMax           float64                `protobuf:"fixed64,18,opt,name=score_max,json=scoreMax,proto3" json:"score_max,omitempty"`
+	PenaltyType        PenaltyType            `protobuf:"varint,19,opt,name=penalty_type,json=penaltyType,proto3,enum=exam.v1.PenaltyType" json:"penalty_type,omitempty"`
+	PenaltyValue       float64                `protobuf:"fixed64,20,opt,name=penalty_value,json=penaltyValue,proto3" json:"penalty_value,omitempty"`
+	AllowNegativeScore bool                   `protobuf:"varint,21,opt,name=allow_negative_score,json=allowNegativeScore,proto3" json:"allow_negative_score,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Exam) Reset() {
@@ -267,6 +485,62 @@ func (x *Exam) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Exam) GetScheduledStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledStartTime
+	}
+	return nil
+}
+
+func (x *Exam) GetScheduledEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledEndTime
+	}
+	return nil
+}
+
+func (x *Exam) GetShuffleChoices() bool {
+	if x != nil {
+		return x.ShuffleChoices
+	}
+	return false
+}
+
+func (x *Exam) GetScorePolicy() ScorePolicy {
+	if x != nil {
+		return x.ScorePolicy
+	}
+	return ScorePolicy_SCORE_POLICY_UNSPECIFIED
+}
+
+func (x *Exam) GetScoreMax() float64 {
+	if x != nil {
+		return x.ScoreMax
+	}
+	return 0
+}
+
+func (x *Exam) GetPenaltyType() PenaltyType {
+	if x != nil {
+		return x.PenaltyType
+	}
+	return PenaltyType_PENALTY_TYPE_UNSPECIFIED
+}
+
+func (x *Exam) GetPenaltyValue() float64 {
+	if x != nil {
+		return x.PenaltyValue
+	}
+	return 0
+}
+
+func (x *Exam) GetAllowNegativeScore() bool {
+	if x != nil {
+		return x.AllowNegativeScore
+	}
+	return false
+}
+
 type CreateExamRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -276,8 +550,24 @@ type CreateExamRequest struct {
 	IsRandom        bool                   `protobuf:"varint,5,opt,name=is_random,json=isRandom,proto3" json:"is_random,omitempty"`
 	TeacherId       string                 `protobuf:"bytes,6,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
 	ClassIds        []string               `protobuf:"bytes,7,rep,name=class_ids,json=classIds,proto3" json:"class_ids,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Optional exam window. When set, the exam is activated and finished
+	// automatically at these instants.
+	ScheduledStartTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=scheduled_start_time,json=scheduledStartTime,proto3" json:"scheduled_start_time,omitempty"`
+	ScheduledEndTime   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=scheduled_end_time,json=scheduledEndTime,proto3" json:"scheduled_end_time,omitempty"`
+	// Shuffle the choice order per session
+	ShuffleChoices bool `protobuf:"varint,10,opt,name=shuffle_choices,json=shuffleChoices,proto3" json:"shuffle_choices,omitempty"`
+	// How raw points become the final score; defaults to PERCENTAGE
+	ScorePolicy ScorePolicy `protobuf:"varint,11,opt,name=score_policy,json=scorePolicy,proto3,enum=exam.v1.ScorePolicy" json:"score_policy,omitempty"`
+	// Maximum score for the SCALED policy; defaults to 100
+	ScoreMax float64 `protobuf:"fixed64,12,opt,name=score_max,json=scoreMax,proto3" json:"score_max,omitempty"`
+	// Deduction for wrong answers: FIXED subtracts penalty_value points,
+	// FRACTION subtracts penalty_value times the question's points
+	PenaltyType  PenaltyType `protobuf:"varint,13,opt,name=penalty_type,json=penaltyType,proto3,enum=exam.v1.PenaltyType" json:"penalty_type,omitempty"`
+	PenaltyValue float64     `protobuf:"fixed64,14,opt,name=penalty_value,json=penaltyValue,proto3" json:"penalty_value,omitempty"`
+	// Let penalties push raw points below zero; by default they floor at zero
+	AllowNegativeScore bool `protobuf:"varint,15,opt,name=allow_negative_score,json=allowNegativeScore,proto3" json:"allow_negative_score,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *CreateExamRequest) Reset() {
@@ -359,6 +649,62 @@ func (x *CreateExamRequest) GetClassIds() []string {
 	return nil
 }
 
+func (x *CreateExamRequest) GetScheduledStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledStartTime
+	}
+	return nil
+}
+
+func (x *CreateExamRequest) GetScheduledEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledEndTime
+	}
+	return nil
+}
+
+func (x *CreateExamRequest) GetShuffleChoices() bool {
+	if x != nil {
+		return x.ShuffleChoices
+	}
+	return false
+}
+
+func (x *CreateExamRequest) GetScorePolicy() ScorePolicy {
+	if x != nil {
+		return x.ScorePolicy
+	}
+	return ScorePolicy_SCORE_POLICY_UNSPECIFIED
+}
+
+func (x *CreateExamRequest) GetScoreMax() float64 {
+	if x != nil {
+		return x.ScoreMax
+	}
+	return 0
+}
+
+func (x *CreateExamRequest) GetPenaltyType() PenaltyType {
+	if x != nil {
+		return x.PenaltyType
+	}
+	return PenaltyType_PENALTY_TYPE_UNSPECIFIED
+}
+
+func (x *CreateExamRequest) GetPenaltyValue() float64 {
+	if x != nil {
+		return x.PenaltyValue
+	}
+	return 0
+}
+
+func (x *CreateExamRequest) GetAllowNegativeScore() bool {
+	if x != nil {
+		return x.AllowNegativeScore
+	}
+	return false
+}
+
 type GetExamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -404,10 +750,29 @@ func (x *GetExamRequest) GetId() string {
 }
 
 type ListExamsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// All filters are optional and combined with AND
+	TeacherId string `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	// Defaults to 10, at most 100
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token from the previous response; empty for the first page.
+	// Only valid with the same sort_by and sort_direction.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Also count all matching exams in total_size
+	IncludeTotal bool      `protobuf:"varint,4,opt,name=include_total,json=includeTotal,proto3" json:"include_total,omitempty"`
+	Subject      string    `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`
+	Status       ExamState `protobuf:"varint,6,opt,name=status,proto3,enum=exam.v1.ExamState" json:"status,omitempty"`
+	// Exams assigned to this class
+	ClassId string `protobuf:"bytes,7,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// Exams whose start_time falls in [start_from, start_to)
+	StartFrom *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=start_from,json=startFrom,proto3" json:"start_from,omitempty"`
+	StartTo   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=start_to,json=startTo,proto3" json:"start_to,omitempty"`
+	// Case-insensitive substring of the title
+	Query string `protobuf:"bytes,10,opt,name=query,proto3" json:"query,omitempty"`
+	// Defaults to CREATED_AT
+	SortBy ExamSortField `protobuf:"varint,11,opt,name=sort_by,json=sortBy,proto3,enum=exam.v1.ExamSortField" json:"sort_by,omitempty"`
+	// Defaults to DESC for CREATED_AT and START_TIME, ASC for TITLE
+	SortDirection SortDirection `protobuf:"varint,12,opt,name=sort_direction,json=sortDirection,proto3,enum=exam.v1.SortDirection" json:"sort_direction,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -463,10 +828,75 @@ func (x *ListExamsRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListExamsRequest) GetIncludeTotal() bool {
+	if x != nil {
+		return x.IncludeTotal
+	}
+	return false
+}
+
+func (x *ListExamsRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *ListExamsRequest) GetStatus() ExamState {
+	if x != nil {
+		return x.Status
+	}
+	return ExamState_EXAM_STATE_UNSPECIFIED
+}
+
+func (x *ListExamsRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *ListExamsRequest) GetStartFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartFrom
+	}
+	return nil
+}
+
+func (x *ListExamsRequest) GetStartTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTo
+	}
+	return nil
+}
+
+func (x *ListExamsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListExamsRequest) GetSortBy() ExamSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return ExamSortField_EXAM_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ListExamsRequest) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
 type ListExamsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exams         []*Exam                `protobuf:"bytes,1,rep,name=exams,proto3" json:"exams,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Exams []*Exam                `protobuf:"bytes,1,rep,name=exams,proto3" json:"exams,omitempty"`
+	// Empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int32  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -515,6 +945,13 @@ func (x *ListExamsResponse) GetNextPageToken() string {
 	return ""
 }
 
+func (x *ListExamsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
 type UpdateExamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -752,14 +1189,15 @@ func (x *GetExamStatusRequest) GetId() string {
 }
 
 type ExamStatus struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ExamId           string                 `protobuf:"bytes,2,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
-	State            ExamState              `protobuf:"varint,3,opt,name=state,proto3,enum=exam.v1.ExamState" json:"state,omitempty"`
-	TotalStudents    int32                  `protobuf:"varint,4,opt,name=total_students,json=totalStudents,proto3" json:"total_students,omitempty"`
-	StudentsStarted  int32                  `protobuf:"varint,5,opt,name=students_started,json=studentsStarted,proto3" json:"students_started,omitempty"`
-	StudentsFinished int32                  `protobuf:"varint,6,opt,name=students_finished,json=studentsFinished,proto3" json:"students_finished,omitempty"`
-	StudentStatuses  []*StudentStatus       `protobuf:"bytes,7,rep,name=student_statuses,json=studentStatuses,proto3" json:"student_statuses,omitempty"`
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExamId          string                 `protobuf:"bytes,2,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	State           ExamState              `protobuf:"varint,3,opt,name=state,proto3,enum=exam.v1.ExamState" json:"state,omitempty"`
+	TotalStudents   int32                  `protobuf:"varint,4,opt,name=total_students,json=totalStudents,proto3" json:"total_students,omitempty"`
+	StudentsStarted int32                  `protobuf:"varint,5,opt,name=students_started,json=studentsStarted,proto3" json:"students_started,omitempty"`
+	// Includes students who timed out
+	StudentsFinished int32            `protobuf:"varint,6,opt,name=students_finished,json=studentsFinished,proto3" json:"students_finished,omitempty"`
+	StudentStatuses  []*StudentStatus `protobuf:"bytes,7,rep,name=student_statuses,json=studentStatuses,proto3" json:"student_statuses,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -844,15 +1282,20 @@ func (x *ExamStatus) GetStudentStatuses() []*StudentStatus {
 }
 
 type StudentStatus struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	StudentName   string                 `protobuf:"bytes,2,opt,name=student_name,json=studentName,proto3" json:"student_name,omitempty"`
-	ClassId       string                 `protobuf:"bytes,3,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
-	State         ExamStudentState       `protobuf:"varint,4,opt,name=state,proto3,enum=exam.v1.ExamStudentState" json:"state,omitempty"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	StudentId   string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	StudentName string                 `protobuf:"bytes,2,opt,name=student_name,json=studentName,proto3" json:"student_name,omitempty"`
+	ClassId     string                 `protobuf:"bytes,3,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	State       ExamStudentState       `protobuf:"varint,4,opt,name=state,proto3,enum=exam.v1.ExamStudentState" json:"state,omitempty"`
+	StartTime   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Empty until the student starts
+	SessionId string `protobuf:"bytes,7,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Questions answered so far out of the questions drawn for the session
+	Answered       int32 `protobuf:"varint,8,opt,name=answered,proto3" json:"answered,omitempty"`
+	TotalQuestions int32 `protobuf:"varint,9,opt,name=total_questions,json=totalQuestions,proto3" json:"total_questions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *StudentStatus) Reset() {
@@ -927,6 +1370,27 @@ func (x *StudentStatus) GetEndTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *StudentStatus) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *StudentStatus) GetAnswered() int32 {
+	if x != nil {
+		return x.Answered
+	}
+	return 0
+}
+
+func (x *StudentStatus) GetTotalQuestions() int32 {
+	if x != nil {
+		return x.TotalQuestions
+	}
+	return 0
+}
+
 var File_api_proto_exam_v1_exam_proto protoreflect.FileDescriptor
 
 var file_api_proto_exam_v1_exam_proto_rawDesc = string([]byte{
@@ -936,7 +1400,7 @@ var file_api_proto_exam_v1_exam_proto_rawDesc = string([]byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
 	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x88, 0x04, 0x0a, 0x04, 0x45, 0x78, 0x61, 0x6d, 0x12, 0x0e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x07, 0x0a, 0x04, 0x45, 0x78, 0x61, 0x6d, 0x12, 0x0e,
 	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
 	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
 	0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18,
@@ -969,88 +1433,188 @@ var file_api_proto_exam_v1_exam_proto_rawDesc = string([]byte{
 	0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
 	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x22, 0xf0, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
-	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65,
-	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
-	0x5f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
-	0x73, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x65, 0x61, 0x63, 0x68,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65, 0x61,
-	0x63, 0x68, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f,
-	0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73,
-	0x49, 0x64, 0x73, 0x22, 0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6d, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x61,
-	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x65, 0x61,
-	0x63, 0x68, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
-	0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
-	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
-	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x60, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x65, 0x78, 0x61,
-	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x05, 0x65, 0x78, 0x61, 0x6d, 0x73, 0x12, 0x26,
-	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
-	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x46, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x04, 0x65,
-	0x78, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x65, 0x78, 0x61, 0x6d,
-	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x04, 0x65, 0x78, 0x61, 0x6d, 0x22, 0x23,
-	0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x12, 0x4c, 0x0a, 0x14, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x48,
+	0x0a, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x68, 0x75, 0x66,
+	0x66, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x12, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x4d, 0x61, 0x78, 0x12, 0x37, 0x0a, 0x0c, 0x70, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6e,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4e, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x97, 0x05, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x29, 0x0a,
+	0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x73, 0x12, 0x4c, 0x0a, 0x14, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x10, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x5f, 0x63, 0x68,
+	0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x68, 0x75,
+	0x66, 0x66, 0x6c, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x14, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x6d, 0x61,
+	0x78, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x61,
+	0x78, 0x12, 0x37, 0x0a, 0x0c, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70,
+	0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x30, 0x0a, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x4e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x22, 0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x42, 0x0a, 0x13, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x45,
-	0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c,
-	0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63,
-	0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x73, 0x22, 0x27, 0x0a, 0x15, 0x44, 0x65, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x26, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa1, 0x02, 0x0a, 0x0a, 0x45, 0x78, 0x61,
-	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64,
-	0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x12, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11,
-	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
-	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x73, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x10, 0x73, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0f, 0x73, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0x8f, 0x02, 0x0a,
-	0x0d, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a,
-	0x0c, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x65, 0x78, 0x61,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x2a, 0x6f,
+	0x02, 0x69, 0x64, 0x22, 0xeb, 0x03, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x65, 0x61, 0x63,
+	0x68, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65,
+	0x61, 0x63, 0x68, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x46, 0x72, 0x6f, 0x6d, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x6f,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x2f, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61,
+	0x6d, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74,
+	0x42, 0x79, 0x12, 0x3d, 0x0a, 0x0e, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x7f, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x65, 0x78, 0x61, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x78, 0x61, 0x6d, 0x52, 0x05, 0x65, 0x78, 0x61, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69,
+	0x7a, 0x65, 0x22, 0x46, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x04, 0x65, 0x78, 0x61, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x78, 0x61, 0x6d, 0x52, 0x04, 0x65, 0x78, 0x61, 0x6d, 0x22, 0x23, 0x0a, 0x11, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x42, 0x0a, 0x13, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x49, 0x64, 0x73, 0x22, 0x27, 0x0a, 0x15, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x26, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0xa1, 0x02, 0x0a, 0x0a, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x0a,
+	0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x73, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0xf3, 0x02, 0x0a, 0x0d, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2a, 0x8b,
+	0x01, 0x0a, 0x0d, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x58, 0x41, 0x4d, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49,
+	0x45, 0x4c, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x58, 0x41, 0x4d, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46,
+	0x49, 0x45, 0x4c, 0x44, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x10,
+	0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x58, 0x41, 0x4d, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46,
+	0x49, 0x45, 0x4c, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10,
+	0x02, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x58, 0x41, 0x4d, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46,
+	0x49, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x10, 0x03, 0x2a, 0x60, 0x0a, 0x0d,
+	0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a,
+	0x1a, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a,
+	0x12, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x41, 0x53, 0x43, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x44, 0x49,
+	0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x02, 0x2a, 0x6f,
 	0x0a, 0x09, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x45,
 	0x58, 0x41, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
 	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x58, 0x41, 0x4d, 0x5f,
@@ -1058,52 +1622,71 @@ var file_api_proto_exam_v1_exam_proto_rawDesc = string([]byte{
 	0x15, 0x0a, 0x11, 0x45, 0x58, 0x41, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x43,
 	0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x58, 0x41, 0x4d, 0x5f, 0x53,
 	0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x03, 0x2a,
-	0x9f, 0x01, 0x0a, 0x10, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x58, 0x41, 0x4d, 0x5f, 0x53, 0x54, 0x55,
-	0x44, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x58, 0x41, 0x4d,
-	0x5f, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4e,
-	0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e,
-	0x45, 0x58, 0x41, 0x4d, 0x5f, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02,
-	0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x58, 0x41, 0x4d, 0x5f, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10,
-	0x03, 0x32, 0x8b, 0x04, 0x0a, 0x0b, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x12,
-	0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x65, 0x78,
-	0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x07,
-	0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x12, 0x17, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x0d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x22,
-	0x00, 0x12, 0x44, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x73, 0x12, 0x19,
-	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x61,
-	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x45, 0x78, 0x61, 0x6d, 0x12, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d,
-	0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d,
-	0x12, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71,
+	0x7e, 0x0a, 0x0b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c,
+	0x0a, 0x18, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17,
+	0x53, 0x43, 0x4f, 0x52, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x50, 0x45, 0x52,
+	0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x43, 0x4f,
+	0x52, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x52, 0x41, 0x57, 0x5f, 0x50, 0x4f,
+	0x49, 0x4e, 0x54, 0x53, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x5f,
+	0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x53, 0x43, 0x41, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a,
+	0x75, 0x0a, 0x0b, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c,
+	0x0a, 0x18, 0x50, 0x45, 0x4e, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11,
+	0x50, 0x45, 0x4e, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x45, 0x4e, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x58, 0x45, 0x44, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x50,
+	0x45, 0x4e, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x52, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x2a, 0xc1, 0x01, 0x0a, 0x10, 0x45, 0x78, 0x61, 0x6d, 0x53,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x45,
+	0x58, 0x41, 0x4d, 0x5f, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x22, 0x0a, 0x1e, 0x45, 0x58, 0x41, 0x4d, 0x5f, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x58, 0x41, 0x4d, 0x5f, 0x53, 0x54, 0x55, 0x44,
+	0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f,
+	0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x58, 0x41, 0x4d, 0x5f,
+	0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x49,
+	0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x58, 0x41, 0x4d,
+	0x5f, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x54,
+	0x49, 0x4d, 0x45, 0x44, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x04, 0x32, 0x8b, 0x04, 0x0a, 0x0b, 0x45,
+	0x78, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x12, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x78, 0x61, 0x6d, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0e, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45,
-	0x78, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x42,
-	0x23, 0x5a, 0x21, 0x63, 0x62, 0x74, 0x2d, 0x65, 0x78, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x78,
-	0x61, 0x6d, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x78, 0x61, 0x6d, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d,
+	0x12, 0x17, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x73, 0x12, 0x19, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x78, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x39, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x12, 0x1a,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45,
+	0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x12, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x3d, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x12,
+	0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x22, 0x00, 0x12, 0x41,
+	0x0a, 0x0e, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d,
+	0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x22,
+	0x00, 0x12, 0x45, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x45, 0x78, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x70, 0x65, 0x73, 0x4a, 0x73, 0x2f, 0x63, 0x62,
+	0x74, 0x2d, 0x65, 0x78, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x65, 0x78, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x78, 0x61, 0x6d, 0x76, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -1118,60 +1701,77 @@ func file_api_proto_exam_v1_exam_proto_rawDescGZIP() []byte {
 	return file_api_proto_exam_v1_exam_proto_rawDescData
 }
 
-var file_api_proto_exam_v1_exam_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_proto_exam_v1_exam_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
 var file_api_proto_exam_v1_exam_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_api_proto_exam_v1_exam_proto_goTypes = []any{
-	(ExamState)(0),                // 0: exam.v1.ExamState
-	(ExamStudentState)(0),         // 1: exam.v1.ExamStudentState
-	(*Exam)(nil),                  // 2: exam.v1.Exam
-	(*CreateExamRequest)(nil),     // 3: exam.v1.CreateExamRequest
-	(*GetExamRequest)(nil),        // 4: exam.v1.GetExamRequest
-	(*ListExamsRequest)(nil),      // 5: exam.v1.ListExamsRequest
-	(*ListExamsResponse)(nil),     // 6: exam.v1.ListExamsResponse
-	(*UpdateExamRequest)(nil),     // 7: exam.v1.UpdateExamRequest
-	(*DeleteExamRequest)(nil),     // 8: exam.v1.DeleteExamRequest
-	(*ActivateExamRequest)(nil),   // 9: exam.v1.ActivateExamRequest
-	(*DeactivateExamRequest)(nil), // 10: exam.v1.DeactivateExamRequest
-	(*GetExamStatusRequest)(nil),  // 11: exam.v1.GetExamStatusRequest
-	(*ExamStatus)(nil),            // 12: exam.v1.ExamStatus
-	(*StudentStatus)(nil),         // 13: exam.v1.StudentStatus
-	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 15: google.protobuf.Empty
+	(ExamSortField)(0),            // 0: exam.v1.ExamSortField
+	(SortDirection)(0),            // 1: exam.v1.SortDirection
+	(ExamState)(0),                // 2: exam.v1.ExamState
+	(ScorePolicy)(0),              // 3: exam.v1.ScorePolicy
+	(PenaltyType)(0),              // 4: exam.v1.PenaltyType
+	(ExamStudentState)(0),         // 5: exam.v1.ExamStudentState
+	(*Exam)(nil),                  // 6: exam.v1.Exam
+	(*CreateExamRequest)(nil),     // 7: exam.v1.CreateExamRequest
+	(*GetExamRequest)(nil),        // 8: exam.v1.GetExamRequest
+	(*ListExamsRequest)(nil),      // 9: exam.v1.ListExamsRequest
+	(*ListExamsResponse)(nil),     // 10: exam.v1.ListExamsResponse
+	(*UpdateExamRequest)(nil),     // 11: exam.v1.UpdateExamRequest
+	(*DeleteExamRequest)(nil),     // 12: exam.v1.DeleteExamRequest
+	(*ActivateExamRequest)(nil),   // 13: exam.v1.ActivateExamRequest
+	(*DeactivateExamRequest)(nil), // 14: exam.v1.DeactivateExamRequest
+	(*GetExamStatusRequest)(nil),  // 15: exam.v1.GetExamStatusRequest
+	(*ExamStatus)(nil),            // 16: exam.v1.ExamStatus
+	(*StudentStatus)(nil),         // 17: exam.v1.StudentStatus
+	(*timestamppb.Timestamp)(nil), // 18: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 19: google.protobuf.Empty
 }
 var file_api_proto_exam_v1_exam_proto_depIdxs = []int32{
-	12, // 0: exam.v1.Exam.status:type_name -> exam.v1.ExamStatus
-	14, // 1: exam.v1.Exam.start_time:type_name -> google.protobuf.Timestamp
-	14, // 2: exam.v1.Exam.end_time:type_name -> google.protobuf.Timestamp
-	14, // 3: exam.v1.Exam.created_at:type_name -> google.protobuf.Timestamp
-	14, // 4: exam.v1.Exam.updated_at:type_name -> google.protobuf.Timestamp
-	2,  // 5: exam.v1.ListExamsResponse.exams:type_name -> exam.v1.Exam
-	2,  // 6: exam.v1.UpdateExamRequest.exam:type_name -> exam.v1.Exam
-	0,  // 7: exam.v1.ExamStatus.state:type_name -> exam.v1.ExamState
-	13, // 8: exam.v1.ExamStatus.student_statuses:type_name -> exam.v1.StudentStatus
-	1,  // 9: exam.v1.StudentStatus.state:type_name -> exam.v1.ExamStudentState
-	14, // 10: exam.v1.StudentStatus.start_time:type_name -> google.protobuf.Timestamp
-	14, // 11: exam.v1.StudentStatus.end_time:type_name -> google.protobuf.Timestamp
-	3,  // 12: exam.v1.ExamService.CreateExam:input_type -> exam.v1.CreateExamRequest
-	4,  // 13: exam.v1.ExamService.GetExam:input_type -> exam.v1.GetExamRequest
-	5,  // 14: exam.v1.ExamService.ListExams:input_type -> exam.v1.ListExamsRequest
-	7,  // 15: exam.v1.ExamService.UpdateExam:input_type -> exam.v1.UpdateExamRequest
-	8,  // 16: exam.v1.ExamService.DeleteExam:input_type -> exam.v1.DeleteExamRequest
-	9,  // 17: exam.v1.ExamService.ActivateExam:input_type -> exam.v1.ActivateExamRequest
-	10, // 18: exam.v1.ExamService.DeactivateExam:input_type -> exam.v1.DeactivateExamRequest
-	11, // 19: exam.v1.ExamService.GetExamStatus:input_type -> exam.v1.GetExamStatusRequest
-	2,  // 20: exam.v1.ExamService.CreateExam:output_type -> exam.v1.Exam
-	2,  // 21: exam.v1.ExamService.GetExam:output_type -> exam.v1.Exam
-	6,  // 22: exam.v1.ExamService.ListExams:output_type -> exam.v1.ListExamsResponse
-	2,  // 23: exam.v1.ExamService.UpdateExam:output_type -> exam.v1.Exam
-	15, // 24: exam.v1.ExamService.DeleteExam:output_type -> google.protobuf.Empty
-	2,  // 25: exam.v1.ExamService.ActivateExam:output_type -> exam.v1.Exam
-	2,  // 26: exam.v1.ExamService.DeactivateExam:output_type -> exam.v1.Exam
-	12, // 27: exam.v1.ExamService.GetExamStatus:output_type -> exam.v1.ExamStatus
-	20, // [20:28] is the sub-list for method output_type
-	12, // [12:20] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	16, // 0: exam.v1.Exam.status:type_name -> exam.v1.ExamStatus
+	18, // 1: exam.v1.Exam.start_time:type_name -> google.protobuf.Timestamp
+	18, // 2: exam.v1.Exam.end_time:type_name -> google.protobuf.Timestamp
+	18, // 3: exam.v1.Exam.created_at:type_name -> google.protobuf.Timestamp
+	18, // 4: exam.v1.Exam.updated_at:type_name -> google.protobuf.Timestamp
+	18, // 5: exam.v1.Exam.scheduled_start_time:type_name -> google.protobuf.Timestamp
+	18, // 6: exam.v1.Exam.scheduled_end_time:type_name -> google.protobuf.Timestamp
+	3,  // 7: exam.v1.Exam.score_policy:type_name -> exam.v1.ScorePolicy
+	4,  // 8: exam.v1.Exam.penalty_type:type_name -> exam.v1.PenaltyType
+	18, // 9: exam.v1.CreateExamRequest.scheduled_start_time:type_name -> google.protobuf.Timestamp
+	18, // 10: exam.v1.CreateExamRequest.scheduled_end_time:type_name -> google.protobuf.Timestamp
+	3,  // 11: exam.v1.CreateExamRequest.score_policy:type_name -> exam.v1.ScorePolicy
+	4,  // 12: exam.v1.CreateExamRequest.penalty_type:type_name -> exam.v1.PenaltyType
+	2,  // 13: exam.v1.ListExamsRequest.status:type_name -> exam.v1.ExamState
+	18, // 14: exam.v1.ListExamsRequest.start_from:type_name -> google.protobuf.Timestamp
+	18, // 15: exam.v1.ListExamsRequest.start_to:type_name -> google.protobuf.Timestamp
+	0,  // 16: exam.v1.ListExamsRequest.sort_by:type_name -> exam.v1.ExamSortField
+	1,  // 17: exam.v1.ListExamsRequest.sort_direction:type_name -> exam.v1.SortDirection
+	6,  // 18: exam.v1.ListExamsResponse.exams:type_name -> exam.v1.Exam
+	6,  // 19: exam.v1.UpdateExamRequest.exam:type_name -> exam.v1.Exam
+	2,  // 20: exam.v1.ExamStatus.state:type_name -> exam.v1.ExamState
+	17, // 21: exam.v1.ExamStatus.student_statuses:type_name -> exam.v1.StudentStatus
+	5,  // 22: exam.v1.StudentStatus.state:type_name -> exam.v1.ExamStudentState
+	18, // 23: exam.v1.StudentStatus.start_time:type_name -> google.protobuf.Timestamp
+	18, // 24: exam.v1.StudentStatus.end_time:type_name -> google.protobuf.Timestamp
+	7,  // 25: exam.v1.ExamService.CreateExam:input_type -> exam.v1.CreateExamRequest
+	8,  // 26: exam.v1.ExamService.GetExam:input_type -> exam.v1.GetExamRequest
+	9,  // 27: exam.v1.ExamService.ListExams:input_type -> exam.v1.ListExamsRequest
+	11, // 28: exam.v1.ExamService.UpdateExam:input_type -> exam.v1.UpdateExamRequest
+	12, // 29: exam.v1.ExamService.DeleteExam:input_type -> exam.v1.DeleteExamRequest
+	13, // 30: exam.v1.ExamService.ActivateExam:input_type -> exam.v1.ActivateExamRequest
+	14, // 31: exam.v1.ExamService.DeactivateExam:input_type -> exam.v1.DeactivateExamRequest
+	15, // 32: exam.v1.ExamService.GetExamStatus:input_type -> exam.v1.GetExamStatusRequest
+	6,  // 33: exam.v1.ExamService.CreateExam:output_type -> exam.v1.Exam
+	6,  // 34: exam.v1.ExamService.GetExam:output_type -> exam.v1.Exam
+	10, // 35: exam.v1.ExamService.ListExams:output_type -> exam.v1.ListExamsResponse
+	6,  // 36: exam.v1.ExamService.UpdateExam:output_type -> exam.v1.Exam
+	19, // 37: exam.v1.ExamService.DeleteExam:output_type -> google.protobuf.Empty
+	6,  // 38: exam.v1.ExamService.ActivateExam:output_type -> exam.v1.Exam
+	6,  // 39: exam.v1.ExamService.DeactivateExam:output_type -> exam.v1.Exam
+	16, // 40: exam.v1.ExamService.GetExamStatus:output_type -> exam.v1.ExamStatus
+	33, // [33:41] is the sub-list for method output_type
+	25, // [25:33] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_exam_v1_exam_proto_init() }
@@ -1184,7 +1784,7 @@ func file_api_proto_exam_v1_exam_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_exam_v1_exam_proto_rawDesc), len(file_api_proto_exam_v1_exam_proto_rawDesc)),
-			NumEnums:      2,
+			NumEnums:      6,
 			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
@@ -20,12 +20,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	QuestionService_CreateQuestion_FullMethodName   = "/question.v1.QuestionService/CreateQuestion"
-	QuestionService_GetQuestion_FullMethodName      = "/question.v1.QuestionService/GetQuestion"
-	QuestionService_ListQuestions_FullMethodName    = "/question.v1.QuestionService/ListQuestions"
-	QuestionService_UpdateQuestion_FullMethodName   = "/question.v1.QuestionService/UpdateQuestion"
-	QuestionService_DeleteQuestion_FullMethodName   = "/question.v1.QuestionService/DeleteQuestion"
-	QuestionService_GetExamQuestions_FullMethodName = "/question.v1.QuestionService/GetExamQuestions"
+	QuestionService_CreateQuestion_FullMethodName      = "/question.v1.QuestionService/CreateQuestion"
+	QuestionService_GetQuestion_FullMethodName         = "/question.v1.QuestionService/GetQuestion"
+	QuestionService_ListQuestions_FullMethodName       = "/question.v1.QuestionService/ListQuestions"
+	QuestionService_UpdateQuestion_FullMethodName      = "/question.v1.QuestionService/UpdateQuestion"
+	QuestionService_DeleteQuestion_FullMethodName      = "/question.v1.QuestionService/DeleteQuestion"
+	QuestionService_GetExamQuestions_FullMethodName    = "/question.v1.QuestionService/GetExamQuestions"
+	QuestionService_GetSessionQuestions_FullMethodName = "/question.v1.QuestionService/GetSessionQuestions"
 )
 
 // QuestionServiceClient is the client API for QuestionService service.
@@ -40,6 +41,7 @@ type QuestionServiceClient interface {
 	DeleteQuestion(ctx context.Context, in *DeleteQuestionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Exam questions
 	GetExamQuestions(ctx context.Context, in *GetExamQuestionsRequest, opts ...grpc.CallOption) (*GetExamQuestionsResponse, error)
+	GetSessionQuestions(ctx context.Context, in *GetSessionQuestionsRequest, opts ...grpc.CallOption) (*GetSessionQuestionsResponse, error)
 }
 
 type questionServiceClient struct {
@@ -110,6 +112,16 @@ func (c *questionServiceClient) GetExamQuestions(ctx context.Context, in *GetExa
 	return out, nil
 }
 
+func (c *questionServiceClient) GetSessionQuestions(ctx context.Context, in *GetSessionQuestionsRequest, opts ...grpc.CallOption) (*GetSessionQuestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSessionQuestionsResponse)
+	err := c.cc.Invoke(ctx, QuestionService_GetSessionQuestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QuestionServiceServer is the server API for QuestionService service.
 // All implementations must embed UnimplementedQuestionServiceServer
 // for forward compatibility.
@@ -122,6 +134,7 @@ type QuestionServiceServer interface {
 	DeleteQuestion(context.Context, *DeleteQuestionRequest) (*emptypb.Empty, error)
 	// Exam questions
 	GetExamQuestions(context.Context, *GetExamQuestionsRequest) (*GetExamQuestionsResponse, error)
+	GetSessionQuestions(context.Context, *GetSessionQuestionsRequest) (*GetSessionQuestionsResponse, error)
 	mustEmbedUnimplementedQuestionServiceServer()
 }
 
@@ -150,6 +163,9 @@ func (UnimplementedQuestionServiceServer) DeleteQuestion(context.Context, *Delet
 func (UnimplementedQuestionServiceServer) GetExamQuestions(context.Context, *GetExamQuestionsRequest) (*GetExamQuestionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetExamQuestions not implemented")
 }
+func (UnimplementedQuestionServiceServer) GetSessionQuestions(context.Context, *GetSessionQuestionsRequest) (*GetSessionQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionQuestions not implemented")
+}
 func (UnimplementedQuestionServiceServer) mustEmbedUnimplementedQuestionServiceServer() {}
 func (UnimplementedQuestionServiceServer) testEmbeddedByValue()                         {}
 
@@ -279,6 +295,24 @@ func _QuestionService_GetExamQuestions_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QuestionService_GetSessionQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).GetSessionQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionService_GetSessionQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).GetSessionQuestions(ctx, req.(*GetSessionQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // QuestionService_ServiceDesc is the grpc.ServiceDesc for QuestionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -310,6 +344,10 @@ var QuestionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetExamQuestions",
 			Handler:    _QuestionService_GetExamQuestions_Handler,
 		},
+		{
+			MethodName: "GetSessionQuestions",
+			Handler:    _QuestionService_GetSessionQuestions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/question/v1/question.proto",
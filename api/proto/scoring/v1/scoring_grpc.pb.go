@@ -19,9 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ScoringService_CalculateScore_FullMethodName = "/scoring.v1.ScoringService/CalculateScore"
-	ScoringService_GetScore_FullMethodName       = "/scoring.v1.ScoringService/GetScore"
-	ScoringService_ListScores_FullMethodName     = "/scoring.v1.ScoringService/ListScores"
+	ScoringService_CalculateScore_FullMethodName      = "/scoring.v1.ScoringService/CalculateScore"
+	ScoringService_GetScore_FullMethodName            = "/scoring.v1.ScoringService/GetScore"
+	ScoringService_ListScores_FullMethodName          = "/scoring.v1.ScoringService/ListScores"
+	ScoringService_ExportScores_FullMethodName        = "/scoring.v1.ScoringService/ExportScores"
+	ScoringService_ListPendingGrading_FullMethodName  = "/scoring.v1.ScoringService/ListPendingGrading"
+	ScoringService_GradeAnswer_FullMethodName         = "/scoring.v1.ScoringService/GradeAnswer"
+	ScoringService_RegradeExam_FullMethodName         = "/scoring.v1.ScoringService/RegradeExam"
+	ScoringService_SetGradingScale_FullMethodName     = "/scoring.v1.ScoringService/SetGradingScale"
+	ScoringService_GetGradingScale_FullMethodName     = "/scoring.v1.ScoringService/GetGradingScale"
+	ScoringService_GetItemAnalysis_FullMethodName     = "/scoring.v1.ScoringService/GetItemAnalysis"
+	ScoringService_GetSessionReport_FullMethodName    = "/scoring.v1.ScoringService/GetSessionReport"
+	ScoringService_ExportExamReports_FullMethodName   = "/scoring.v1.ScoringService/ExportExamReports"
+	ScoringService_GetExamStatistics_FullMethodName   = "/scoring.v1.ScoringService/GetExamStatistics"
+	ScoringService_AnalyzeCollusion_FullMethodName    = "/scoring.v1.ScoringService/AnalyzeCollusion"
+	ScoringService_ListCollusionFlags_FullMethodName  = "/scoring.v1.ScoringService/ListCollusionFlags"
+	ScoringService_ReviewCollusionFlag_FullMethodName = "/scoring.v1.ScoringService/ReviewCollusionFlag"
 )
 
 // ScoringServiceClient is the client API for ScoringService service.
@@ -32,6 +45,29 @@ type ScoringServiceClient interface {
 	CalculateScore(ctx context.Context, in *CalculateScoreRequest, opts ...grpc.CallOption) (*ExamScore, error)
 	GetScore(ctx context.Context, in *GetScoreRequest, opts ...grpc.CallOption) (*ExamScore, error)
 	ListScores(ctx context.Context, in *ListScoresRequest, opts ...grpc.CallOption) (*ListScoresResponse, error)
+	// Streams every score of an exam for the grade book, ordered by class and name
+	ExportScores(ctx context.Context, in *ExportScoresRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ScoreExportRow], error)
+	// Manual grading of essay and short-answer questions
+	ListPendingGrading(ctx context.Context, in *ListPendingGradingRequest, opts ...grpc.CallOption) (*ListPendingGradingResponse, error)
+	GradeAnswer(ctx context.Context, in *GradeAnswerRequest, opts ...grpc.CallOption) (*GradeAnswerResponse, error)
+	// Recompute stored scores after an answer key change
+	RegradeExam(ctx context.Context, in *RegradeExamRequest, opts ...grpc.CallOption) (*RegradeExamResponse, error)
+	// Pass marks and letter grades, per exam or per subject. Saving a scale
+	// updates passed and grade of the stored scores that follow it.
+	SetGradingScale(ctx context.Context, in *SetGradingScaleRequest, opts ...grpc.CallOption) (*GradingScale, error)
+	GetGradingScale(ctx context.Context, in *GetGradingScaleRequest, opts ...grpc.CallOption) (*GradingScale, error)
+	// Item analysis over finished sessions of an exam
+	GetItemAnalysis(ctx context.Context, in *GetItemAnalysisRequest, opts ...grpc.CallOption) (*GetItemAnalysisResponse, error)
+	// Printable PDF result reports, per session or for every finished session of an exam
+	GetSessionReport(ctx context.Context, in *GetSessionReportRequest, opts ...grpc.CallOption) (*ReportFile, error)
+	ExportExamReports(ctx context.Context, in *ExportExamReportsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReportFile], error)
+	// Score distribution and reliability of an exam
+	GetExamStatistics(ctx context.Context, in *GetExamStatisticsRequest, opts ...grpc.CallOption) (*ExamStatistics, error)
+	// Pairs of sessions sharing improbably many identical wrong answers. Finished
+	// exams are analysed in the background; AnalyzeCollusion reruns on demand.
+	AnalyzeCollusion(ctx context.Context, in *AnalyzeCollusionRequest, opts ...grpc.CallOption) (*AnalyzeCollusionResponse, error)
+	ListCollusionFlags(ctx context.Context, in *ListCollusionFlagsRequest, opts ...grpc.CallOption) (*ListCollusionFlagsResponse, error)
+	ReviewCollusionFlag(ctx context.Context, in *ReviewCollusionFlagRequest, opts ...grpc.CallOption) (*CollusionFlag, error)
 }
 
 type scoringServiceClient struct {
@@ -72,6 +108,154 @@ func (c *scoringServiceClient) ListScores(ctx context.Context, in *ListScoresReq
 	return out, nil
 }
 
+func (c *scoringServiceClient) ExportScores(ctx context.Context, in *ExportScoresRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ScoreExportRow], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ScoringService_ServiceDesc.Streams[0], ScoringService_ExportScores_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportScoresRequest, ScoreExportRow]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScoringService_ExportScoresClient = grpc.ServerStreamingClient[ScoreExportRow]
+
+func (c *scoringServiceClient) ListPendingGrading(ctx context.Context, in *ListPendingGradingRequest, opts ...grpc.CallOption) (*ListPendingGradingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingGradingResponse)
+	err := c.cc.Invoke(ctx, ScoringService_ListPendingGrading_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoringServiceClient) GradeAnswer(ctx context.Context, in *GradeAnswerRequest, opts ...grpc.CallOption) (*GradeAnswerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GradeAnswerResponse)
+	err := c.cc.Invoke(ctx, ScoringService_GradeAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoringServiceClient) RegradeExam(ctx context.Context, in *RegradeExamRequest, opts ...grpc.CallOption) (*RegradeExamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegradeExamResponse)
+	err := c.cc.Invoke(ctx, ScoringService_RegradeExam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoringServiceClient) SetGradingScale(ctx context.Context, in *SetGradingScaleRequest, opts ...grpc.CallOption) (*GradingScale, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GradingScale)
+	err := c.cc.Invoke(ctx, ScoringService_SetGradingScale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoringServiceClient) GetGradingScale(ctx context.Context, in *GetGradingScaleRequest, opts ...grpc.CallOption) (*GradingScale, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GradingScale)
+	err := c.cc.Invoke(ctx, ScoringService_GetGradingScale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoringServiceClient) GetItemAnalysis(ctx context.Context, in *GetItemAnalysisRequest, opts ...grpc.CallOption) (*GetItemAnalysisResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetItemAnalysisResponse)
+	err := c.cc.Invoke(ctx, ScoringService_GetItemAnalysis_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoringServiceClient) GetSessionReport(ctx context.Context, in *GetSessionReportRequest, opts ...grpc.CallOption) (*ReportFile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportFile)
+	err := c.cc.Invoke(ctx, ScoringService_GetSessionReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoringServiceClient) ExportExamReports(ctx context.Context, in *ExportExamReportsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReportFile], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ScoringService_ServiceDesc.Streams[1], ScoringService_ExportExamReports_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportExamReportsRequest, ReportFile]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScoringService_ExportExamReportsClient = grpc.ServerStreamingClient[ReportFile]
+
+func (c *scoringServiceClient) GetExamStatistics(ctx context.Context, in *GetExamStatisticsRequest, opts ...grpc.CallOption) (*ExamStatistics, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExamStatistics)
+	err := c.cc.Invoke(ctx, ScoringService_GetExamStatistics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoringServiceClient) AnalyzeCollusion(ctx context.Context, in *AnalyzeCollusionRequest, opts ...grpc.CallOption) (*AnalyzeCollusionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnalyzeCollusionResponse)
+	err := c.cc.Invoke(ctx, ScoringService_AnalyzeCollusion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoringServiceClient) ListCollusionFlags(ctx context.Context, in *ListCollusionFlagsRequest, opts ...grpc.CallOption) (*ListCollusionFlagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollusionFlagsResponse)
+	err := c.cc.Invoke(ctx, ScoringService_ListCollusionFlags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoringServiceClient) ReviewCollusionFlag(ctx context.Context, in *ReviewCollusionFlagRequest, opts ...grpc.CallOption) (*CollusionFlag, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollusionFlag)
+	err := c.cc.Invoke(ctx, ScoringService_ReviewCollusionFlag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ScoringServiceServer is the server API for ScoringService service.
 // All implementations must embed UnimplementedScoringServiceServer
 // for forward compatibility.
@@ -80,6 +264,29 @@ type ScoringServiceServer interface {
 	CalculateScore(context.Context, *CalculateScoreRequest) (*ExamScore, error)
 	GetScore(context.Context, *GetScoreRequest) (*ExamScore, error)
 	ListScores(context.Context, *ListScoresRequest) (*ListScoresResponse, error)
+	// Streams every score of an exam for the grade book, ordered by class and name
+	ExportScores(*ExportScoresRequest, grpc.ServerStreamingServer[ScoreExportRow]) error
+	// Manual grading of essay and short-answer questions
+	ListPendingGrading(context.Context, *ListPendingGradingRequest) (*ListPendingGradingResponse, error)
+	GradeAnswer(context.Context, *GradeAnswerRequest) (*GradeAnswerResponse, error)
+	// Recompute stored scores after an answer key change
+	RegradeExam(context.Context, *RegradeExamRequest) (*RegradeExamResponse, error)
+	// Pass marks and letter grades, per exam or per subject. Saving a scale
+	// updates passed and grade of the stored scores that follow it.
+	SetGradingScale(context.Context, *SetGradingScaleRequest) (*GradingScale, error)
+	GetGradingScale(context.Context, *GetGradingScaleRequest) (*GradingScale, error)
+	// Item analysis over finished sessions of an exam
+	GetItemAnalysis(context.Context, *GetItemAnalysisRequest) (*GetItemAnalysisResponse, error)
+	// Printable PDF result reports, per session or for every finished session of an exam
+	GetSessionReport(context.Context, *GetSessionReportRequest) (*ReportFile, error)
+	ExportExamReports(*ExportExamReportsRequest, grpc.ServerStreamingServer[ReportFile]) error
+	// Score distribution and reliability of an exam
+	GetExamStatistics(context.Context, *GetExamStatisticsRequest) (*ExamStatistics, error)
+	// Pairs of sessions sharing improbably many identical wrong answers. Finished
+	// exams are analysed in the background; AnalyzeCollusion reruns on demand.
+	AnalyzeCollusion(context.Context, *AnalyzeCollusionRequest) (*AnalyzeCollusionResponse, error)
+	ListCollusionFlags(context.Context, *ListCollusionFlagsRequest) (*ListCollusionFlagsResponse, error)
+	ReviewCollusionFlag(context.Context, *ReviewCollusionFlagRequest) (*CollusionFlag, error)
 	mustEmbedUnimplementedScoringServiceServer()
 }
 
@@ -99,6 +306,45 @@ func (UnimplementedScoringServiceServer) GetScore(context.Context, *GetScoreRequ
 func (UnimplementedScoringServiceServer) ListScores(context.Context, *ListScoresRequest) (*ListScoresResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListScores not implemented")
 }
+func (UnimplementedScoringServiceServer) ExportScores(*ExportScoresRequest, grpc.ServerStreamingServer[ScoreExportRow]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportScores not implemented")
+}
+func (UnimplementedScoringServiceServer) ListPendingGrading(context.Context, *ListPendingGradingRequest) (*ListPendingGradingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingGrading not implemented")
+}
+func (UnimplementedScoringServiceServer) GradeAnswer(context.Context, *GradeAnswerRequest) (*GradeAnswerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GradeAnswer not implemented")
+}
+func (UnimplementedScoringServiceServer) RegradeExam(context.Context, *RegradeExamRequest) (*RegradeExamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegradeExam not implemented")
+}
+func (UnimplementedScoringServiceServer) SetGradingScale(context.Context, *SetGradingScaleRequest) (*GradingScale, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGradingScale not implemented")
+}
+func (UnimplementedScoringServiceServer) GetGradingScale(context.Context, *GetGradingScaleRequest) (*GradingScale, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGradingScale not implemented")
+}
+func (UnimplementedScoringServiceServer) GetItemAnalysis(context.Context, *GetItemAnalysisRequest) (*GetItemAnalysisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItemAnalysis not implemented")
+}
+func (UnimplementedScoringServiceServer) GetSessionReport(context.Context, *GetSessionReportRequest) (*ReportFile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionReport not implemented")
+}
+func (UnimplementedScoringServiceServer) ExportExamReports(*ExportExamReportsRequest, grpc.ServerStreamingServer[ReportFile]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportExamReports not implemented")
+}
+func (UnimplementedScoringServiceServer) GetExamStatistics(context.Context, *GetExamStatisticsRequest) (*ExamStatistics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExamStatistics not implemented")
+}
+func (UnimplementedScoringServiceServer) AnalyzeCollusion(context.Context, *AnalyzeCollusionRequest) (*AnalyzeCollusionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeCollusion not implemented")
+}
+func (UnimplementedScoringServiceServer) ListCollusionFlags(context.Context, *ListCollusionFlagsRequest) (*ListCollusionFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollusionFlags not implemented")
+}
+func (UnimplementedScoringServiceServer) ReviewCollusionFlag(context.Context, *ReviewCollusionFlagRequest) (*CollusionFlag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewCollusionFlag not implemented")
+}
 func (UnimplementedScoringServiceServer) mustEmbedUnimplementedScoringServiceServer() {}
 func (UnimplementedScoringServiceServer) testEmbeddedByValue()                        {}
 
@@ -174,6 +420,226 @@ func _ScoringService_ListScores_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScoringService_ExportScores_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportScoresRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ScoringServiceServer).ExportScores(m, &grpc.GenericServerStream[ExportScoresRequest, ScoreExportRow]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScoringService_ExportScoresServer = grpc.ServerStreamingServer[ScoreExportRow]
+
+func _ScoringService_ListPendingGrading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingGradingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).ListPendingGrading(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_ListPendingGrading_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).ListPendingGrading(ctx, req.(*ListPendingGradingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoringService_GradeAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradeAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).GradeAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_GradeAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).GradeAnswer(ctx, req.(*GradeAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoringService_RegradeExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegradeExamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).RegradeExam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_RegradeExam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).RegradeExam(ctx, req.(*RegradeExamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoringService_SetGradingScale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGradingScaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).SetGradingScale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_SetGradingScale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).SetGradingScale(ctx, req.(*SetGradingScaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoringService_GetGradingScale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradingScaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).GetGradingScale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_GetGradingScale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).GetGradingScale(ctx, req.(*GetGradingScaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoringService_GetItemAnalysis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemAnalysisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).GetItemAnalysis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_GetItemAnalysis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).GetItemAnalysis(ctx, req.(*GetItemAnalysisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoringService_GetSessionReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).GetSessionReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_GetSessionReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).GetSessionReport(ctx, req.(*GetSessionReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoringService_ExportExamReports_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportExamReportsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ScoringServiceServer).ExportExamReports(m, &grpc.GenericServerStream[ExportExamReportsRequest, ReportFile]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScoringService_ExportExamReportsServer = grpc.ServerStreamingServer[ReportFile]
+
+func _ScoringService_GetExamStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExamStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).GetExamStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_GetExamStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).GetExamStatistics(ctx, req.(*GetExamStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoringService_AnalyzeCollusion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeCollusionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).AnalyzeCollusion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_AnalyzeCollusion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).AnalyzeCollusion(ctx, req.(*AnalyzeCollusionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoringService_ListCollusionFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollusionFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).ListCollusionFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_ListCollusionFlags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).ListCollusionFlags(ctx, req.(*ListCollusionFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoringService_ReviewCollusionFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewCollusionFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).ReviewCollusionFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_ReviewCollusionFlag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).ReviewCollusionFlag(ctx, req.(*ReviewCollusionFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ScoringService_ServiceDesc is the grpc.ServiceDesc for ScoringService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -193,7 +659,62 @@ var ScoringService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListScores",
 			Handler:    _ScoringService_ListScores_Handler,
 		},
+		{
+			MethodName: "ListPendingGrading",
+			Handler:    _ScoringService_ListPendingGrading_Handler,
+		},
+		{
+			MethodName: "GradeAnswer",
+			Handler:    _ScoringService_GradeAnswer_Handler,
+		},
+		{
+			MethodName: "RegradeExam",
+			Handler:    _ScoringService_RegradeExam_Handler,
+		},
+		{
+			MethodName: "SetGradingScale",
+			Handler:    _ScoringService_SetGradingScale_Handler,
+		},
+		{
+			MethodName: "GetGradingScale",
+			Handler:    _ScoringService_GetGradingScale_Handler,
+		},
+		{
+			MethodName: "GetItemAnalysis",
+			Handler:    _ScoringService_GetItemAnalysis_Handler,
+		},
+		{
+			MethodName: "GetSessionReport",
+			Handler:    _ScoringService_GetSessionReport_Handler,
+		},
+		{
+			MethodName: "GetExamStatistics",
+			Handler:    _ScoringService_GetExamStatistics_Handler,
+		},
+		{
+			MethodName: "AnalyzeCollusion",
+			Handler:    _ScoringService_AnalyzeCollusion_Handler,
+		},
+		{
+			MethodName: "ListCollusionFlags",
+			Handler:    _ScoringService_ListCollusionFlags_Handler,
+		},
+		{
+			MethodName: "ReviewCollusionFlag",
+			Handler:    _ScoringService_ReviewCollusionFlag_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportScores",
+			Handler:       _ScoringService_ExportScores_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportExamReports",
+			Handler:       _ScoringService_ExportExamReports_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/scoring/v1/scoring.proto",
 }
@@ -24,6 +24,7 @@ const (
 	SessionService_SubmitAnswer_FullMethodName     = "/session.v1.SessionService/SubmitAnswer"
 	SessionService_FinishSession_FullMethodName    = "/session.v1.SessionService/FinishSession"
 	SessionService_GetRemainingTime_FullMethodName = "/session.v1.SessionService/GetRemainingTime"
+	SessionService_GrantExtraTime_FullMethodName   = "/session.v1.SessionService/GrantExtraTime"
 )
 
 // SessionServiceClient is the client API for SessionService service.
@@ -37,6 +38,7 @@ type SessionServiceClient interface {
 	FinishSession(ctx context.Context, in *FinishSessionRequest, opts ...grpc.CallOption) (*ExamSession, error)
 	// Timer management
 	GetRemainingTime(ctx context.Context, in *GetRemainingTimeRequest, opts ...grpc.CallOption) (*GetRemainingTimeResponse, error)
+	GrantExtraTime(ctx context.Context, in *GrantExtraTimeRequest, opts ...grpc.CallOption) (*ExamSession, error)
 }
 
 type sessionServiceClient struct {
@@ -97,6 +99,16 @@ func (c *sessionServiceClient) GetRemainingTime(ctx context.Context, in *GetRema
 	return out, nil
 }
 
+func (c *sessionServiceClient) GrantExtraTime(ctx context.Context, in *GrantExtraTimeRequest, opts ...grpc.CallOption) (*ExamSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExamSession)
+	err := c.cc.Invoke(ctx, SessionService_GrantExtraTime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SessionServiceServer is the server API for SessionService service.
 // All implementations must embed UnimplementedSessionServiceServer
 // for forward compatibility.
@@ -108,6 +120,7 @@ type SessionServiceServer interface {
 	FinishSession(context.Context, *FinishSessionRequest) (*ExamSession, error)
 	// Timer management
 	GetRemainingTime(context.Context, *GetRemainingTimeRequest) (*GetRemainingTimeResponse, error)
+	GrantExtraTime(context.Context, *GrantExtraTimeRequest) (*ExamSession, error)
 	mustEmbedUnimplementedSessionServiceServer()
 }
 
@@ -133,6 +146,9 @@ func (UnimplementedSessionServiceServer) FinishSession(context.Context, *FinishS
 func (UnimplementedSessionServiceServer) GetRemainingTime(context.Context, *GetRemainingTimeRequest) (*GetRemainingTimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRemainingTime not implemented")
 }
+func (UnimplementedSessionServiceServer) GrantExtraTime(context.Context, *GrantExtraTimeRequest) (*ExamSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantExtraTime not implemented")
+}
 func (UnimplementedSessionServiceServer) mustEmbedUnimplementedSessionServiceServer() {}
 func (UnimplementedSessionServiceServer) testEmbeddedByValue()                        {}
 
@@ -244,6 +260,24 @@ func _SessionService_GetRemainingTime_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_GrantExtraTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantExtraTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).GrantExtraTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_GrantExtraTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).GrantExtraTime(ctx, req.(*GrantExtraTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SessionService_ServiceDesc is the grpc.ServiceDesc for SessionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -271,6 +305,10 @@ var SessionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRemainingTime",
 			Handler:    _SessionService_GetRemainingTime_Handler,
 		},
+		{
+			MethodName: "GrantExtraTime",
+			Handler:    _SessionService_GrantExtraTime_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/session/v1/session.proto",
@@ -17,6 +17,7 @@ import (
 	examv1 "github.com/ApesJs/cbt-exam/api/proto/exam/v1"
 	"github.com/ApesJs/cbt-exam/internal/exam/repository/postgres"
 	"github.com/ApesJs/cbt-exam/internal/exam/service"
+	"github.com/ApesJs/cbt-exam/pkg/client"
 	"github.com/ApesJs/cbt-exam/pkg/config"
 )
 
@@ -42,8 +43,28 @@ func main() {
 	// Initialize repository
 	repo := postgres.NewPostgresRepository(db)
 
+	// Initialize service client
+	pkgClient, err := client.NewServiceClient(
+		cfg.ExamPort,
+		cfg.QuestionPort,
+		cfg.SessionPort,
+		cfg.ScoringPort,
+		cfg.UserPort,
+	)
+	if err != nil {
+		log.Fatalf("Failed to create service pkgClient: %v", err)
+	}
+
 	// Initialize service
-	svc := service.NewExamService(repo)
+	svc := service.NewExamService(repo, pkgClient)
+
+	// Handle shutdown gracefully
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Start exam window scheduler
+	scheduler := service.NewScheduler(repo, pkgClient, cfg.ExamSchedulerInterval)
+	go scheduler.Run(ctx)
 
 	// Initialize gRPC server
 	server := grpc.NewServer()
@@ -58,10 +79,6 @@ func main() {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	// Handle shutdown gracefully
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
-	defer stop()
-
 	go func() {
 		<-ctx.Done()
 		log.Println("Shutting down server...")
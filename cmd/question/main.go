@@ -34,6 +34,7 @@ func main() {
 		cfg.QuestionPort,
 		cfg.SessionPort,
 		cfg.ScoringPort,
+		cfg.UserPort,
 	)
 	if err != nil {
 		log.Fatalf("Failed to create service pkgClient: %v", err)
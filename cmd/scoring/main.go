@@ -45,6 +45,18 @@ func main() {
 	// Initialize service
 	svc := service.NewScoringService(repo)
 
+	// Handle shutdown gracefully
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Start session event consumer for automatic scoring
+	consumer := service.NewEventConsumer(repo, cfg.ScoringEventInterval)
+	go consumer.Run(ctx)
+
+	// Start collusion analysis for finished exams
+	collusionJob := service.NewCollusionJob(repo, cfg.CollusionJobInterval)
+	go collusionJob.Run(ctx)
+
 	// Initialize gRPC server
 	server := grpc.NewServer()
 	scoringv1.RegisterScoringServiceServer(server, svc)
@@ -58,10 +70,6 @@ func main() {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	// Handle shutdown gracefully
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
-	defer stop()
-
 	go func() {
 		<-ctx.Done()
 		log.Println("Shutting down server...")
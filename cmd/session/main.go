@@ -17,6 +17,7 @@ import (
 	sessionv1 "github.com/ApesJs/cbt-exam/api/proto/session/v1"
 	"github.com/ApesJs/cbt-exam/internal/session/repository/postgres"
 	"github.com/ApesJs/cbt-exam/internal/session/service"
+	"github.com/ApesJs/cbt-exam/pkg/client"
 	"github.com/ApesJs/cbt-exam/pkg/config"
 )
 
@@ -27,6 +28,18 @@ func main() {
 		log.Fatalf("Failed to load config: %v", err)
 	}
 
+	// Initialize service pkgClient
+	pkgClient, err := client.NewServiceClient(
+		cfg.ExamPort,
+		cfg.QuestionPort,
+		cfg.SessionPort,
+		cfg.ScoringPort,
+		cfg.UserPort,
+	)
+	if err != nil {
+		log.Fatalf("Failed to create service pkgClient: %v", err)
+	}
+
 	// Initialize PostgreSQL connection
 	db, err := sql.Open("postgres", cfg.DatabaseURL)
 	if err != nil {
@@ -43,7 +56,15 @@ func main() {
 	repo := postgres.NewPostgresRepository(db)
 
 	// Initialize service
-	svc := service.NewSessionService(repo)
+	svc := service.NewSessionService(repo, pkgClient)
+
+	// Handle shutdown gracefully
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Start expired session reaper
+	reaper := service.NewReaper(repo, cfg.SessionReaperInterval)
+	go reaper.Run(ctx)
 
 	// Initialize gRPC server
 	server := grpc.NewServer()
@@ -58,10 +79,6 @@ func main() {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	// Handle shutdown gracefully
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
-	defer stop()
-
 	go func() {
 		<-ctx.Done()
 		log.Println("Shutting down server...")
@@ -15,22 +15,85 @@ const (
 	ExamStudentStateNotStarted ExamStudentState = "NOT_STARTED"
 	ExamStudentStateInProgress ExamStudentState = "IN_PROGRESS"
 	ExamStudentStateFinished   ExamStudentState = "FINISHED"
+	// ExamStudentStateTimedOut adalah siswa yang sesinya ditutup karena waktu habis
+	ExamStudentStateTimedOut ExamStudentState = "TIMED_OUT"
+)
+
+// ScorePolicy menentukan cara poin mentah diubah menjadi nilai akhir
+type ScorePolicy string
+
+const (
+	// ScorePolicyPercentage: poin / poin maksimal * 100
+	ScorePolicyPercentage ScorePolicy = "PERCENTAGE"
+	// ScorePolicyRawPoints: nilai akhir sama dengan jumlah poin
+	ScorePolicyRawPoints ScorePolicy = "RAW_POINTS"
+	// ScorePolicyScaled: poin / poin maksimal * ScoreMax
+	ScorePolicyScaled ScorePolicy = "SCALED"
+)
+
+// PenaltyType menentukan pengurangan poin untuk jawaban salah
+type PenaltyType string
+
+const (
+	PenaltyNone PenaltyType = "NONE"
+	// PenaltyFixed: mengurangi PenaltyValue poin per jawaban salah
+	PenaltyFixed PenaltyType = "FIXED"
+	// PenaltyFraction: mengurangi PenaltyValue dikali bobot soal per jawaban salah
+	PenaltyFraction PenaltyType = "FRACTION"
 )
 
 type Exam struct {
-	ID             string    `json:"id"`
-	Title          string    `json:"title"`
-	Subject        string    `json:"subject"`
-	DurationMins   int32     `json:"duration_minutes"`
-	TotalQuestions int32     `json:"total_questions"`
-	IsRandom       bool      `json:"is_random"`
-	TeacherID      string    `json:"teacher_id"`
-	ClassIDs       []string  `json:"class_ids"`
-	Status         ExamState `json:"status"`
-	StartTime      time.Time `json:"start_time"`
-	EndTime        time.Time `json:"end_time"`
-	CreatedAt      time.Time `json:"created_at"`
-	UpdatedAt      time.Time `json:"updated_at"`
+	ID                 string      `json:"id"`
+	Title              string      `json:"title"`
+	Subject            string      `json:"subject"`
+	DurationMins       int32       `json:"duration_minutes"`
+	TotalQuestions     int32       `json:"total_questions"`
+	IsRandom           bool        `json:"is_random"`
+	ShuffleChoices     bool        `json:"shuffle_choices"`
+	ScorePolicy        ScorePolicy `json:"score_policy"`
+	ScoreMax           float64     `json:"score_max"`
+	PenaltyType        PenaltyType `json:"penalty_type"`
+	PenaltyValue       float64     `json:"penalty_value"`
+	AllowNegativeScore bool        `json:"allow_negative_score"`
+	TeacherID          string      `json:"teacher_id"`
+	ClassIDs           []string    `json:"class_ids"`
+	Status             ExamState   `json:"status"`
+	StartTime          time.Time   `json:"start_time"`
+	EndTime            time.Time   `json:"end_time"`
+	ScheduledStartTime time.Time   `json:"scheduled_start_time"`
+	ScheduledEndTime   time.Time   `json:"scheduled_end_time"`
+	CreatedAt          time.Time   `json:"created_at"`
+	UpdatedAt          time.Time   `json:"updated_at"`
+}
+
+// HasSchedule melaporkan apakah ujian memiliki jadwal buka/tutup otomatis
+func (e *Exam) HasSchedule() bool {
+	return !e.ScheduledStartTime.IsZero() || !e.ScheduledEndTime.IsZero()
+}
+
+// ExamSort adalah kolom urutan daftar ujian
+type ExamSort string
+
+const (
+	ExamSortCreatedAt ExamSort = "CREATED_AT"
+	// ExamSortStartTime: ujian yang belum pernah dimulai dianggap paling awal
+	ExamSortStartTime ExamSort = "START_TIME"
+	ExamSortTitle     ExamSort = "TITLE"
+)
+
+// ExamFilter adalah kriteria daftar ujian; field kosong tidak membatasi hasil
+type ExamFilter struct {
+	TeacherID string
+	Subject   string
+	Status    ExamState
+	ClassID   string
+	// Rentang start_time [StartFrom, StartTo)
+	StartFrom time.Time
+	StartTo   time.Time
+	// Search mencari judul yang mengandung teks ini, tanpa membedakan huruf besar
+	Search     string
+	Sort       ExamSort
+	Descending bool
 }
 
 type StudentStatus struct {
@@ -40,6 +103,10 @@ type StudentStatus struct {
 	State       ExamStudentState `json:"state"`
 	StartTime   time.Time        `json:"start_time"`
 	EndTime     time.Time        `json:"end_time"`
+	// Progres sesi; kosong jika siswa belum mulai
+	SessionID      string `json:"session_id"`
+	Answered       int32  `json:"answered"`
+	TotalQuestions int32  `json:"total_questions"`
 }
 
 type ExamStatus struct {
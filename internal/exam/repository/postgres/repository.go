@@ -3,17 +3,81 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
 
 	"github.com/ApesJs/cbt-exam/internal/exam/domain"
 	"github.com/ApesJs/cbt-exam/internal/exam/repository"
+	"github.com/ApesJs/cbt-exam/pkg/pagination"
 )
 
 type postgresRepository struct {
 	db *sql.DB
 }
 
+// selectExamQuery memilih kolom ujian beserta kelasnya; pemanggil menambahkan
+// klausa WHERE lalu GROUP BY e.id
+const selectExamQuery = `
+        SELECT e.id, e.title, e.subject, e.duration_mins, e.total_questions, 
+               e.is_random, e.shuffle_choices, e.score_policy, e.score_max,
+               e.penalty_type, e.penalty_value, e.allow_negative_score, e.teacher_id, e.status, e.start_time, e.end_time,
+               e.scheduled_start_time, e.scheduled_end_time,
+               e.created_at, e.updated_at,
+               COALESCE(array_agg(ec.class_id) FILTER (WHERE ec.class_id IS NOT NULL), '{}') as class_ids
+        FROM exams e
+        LEFT JOIN exam_classes ec ON e.id = ec.exam_id`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanExam(row rowScanner) (*domain.Exam, error) {
+	exam := &domain.Exam{}
+	var startTime, endTime, scheduledStart, scheduledEnd sql.NullTime
+
+	err := row.Scan(
+		&exam.ID,
+		&exam.Title,
+		&exam.Subject,
+		&exam.DurationMins,
+		&exam.TotalQuestions,
+		&exam.IsRandom,
+		&exam.ShuffleChoices,
+		&exam.ScorePolicy,
+		&exam.ScoreMax,
+		&exam.PenaltyType,
+		&exam.PenaltyValue,
+		&exam.AllowNegativeScore,
+		&exam.TeacherID,
+		&exam.Status,
+		&startTime,
+		&endTime,
+		&scheduledStart,
+		&scheduledEnd,
+		&exam.CreatedAt,
+		&exam.UpdatedAt,
+		pq.Array(&exam.ClassIDs),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	exam.StartTime = startTime.Time
+	exam.EndTime = endTime.Time
+	exam.ScheduledStartTime = scheduledStart.Time
+	exam.ScheduledEndTime = scheduledEnd.Time
+
+	return exam, nil
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
 func NewPostgresRepository(db *sql.DB) repository.ExamRepository {
 	return &postgresRepository{
 		db: db,
@@ -29,8 +93,10 @@ func (r *postgresRepository) Create(ctx context.Context, exam *domain.Exam) erro
 
 	// Insert exam
 	query := `
-        INSERT INTO exams (title, subject, duration_mins, total_questions, is_random, teacher_id, status)
-        VALUES ($1, $2, $3, $4, $5, $6, $7)
+        INSERT INTO exams (title, subject, duration_mins, total_questions, is_random, teacher_id, status,
+                           scheduled_start_time, scheduled_end_time, shuffle_choices,
+                           score_policy, score_max, penalty_type, penalty_value, allow_negative_score)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
         RETURNING id, created_at, updated_at`
 
 	err = tx.QueryRowContext(
@@ -43,6 +109,14 @@ func (r *postgresRepository) Create(ctx context.Context, exam *domain.Exam) erro
 		exam.IsRandom,
 		exam.TeacherID,
 		exam.Status,
+		nullTime(exam.ScheduledStartTime),
+		nullTime(exam.ScheduledEndTime),
+		exam.ShuffleChoices,
+		exam.ScorePolicy,
+		exam.ScoreMax,
+		exam.PenaltyType,
+		exam.PenaltyValue,
+		exam.AllowNegativeScore,
 	).Scan(&exam.ID, &exam.CreatedAt, &exam.UpdatedAt)
 	if err != nil {
 		return errors.Wrap(err, "failed to insert exam")
@@ -64,34 +138,11 @@ func (r *postgresRepository) Create(ctx context.Context, exam *domain.Exam) erro
 }
 
 func (r *postgresRepository) GetByID(ctx context.Context, id string) (*domain.Exam, error) {
-	exam := &domain.Exam{}
-
-	query := `
-        SELECT e.id, e.title, e.subject, e.duration_mins, e.total_questions, 
-               e.is_random, e.teacher_id, e.status, e.start_time, e.end_time,
-               e.created_at, e.updated_at,
-               array_agg(ec.class_id) as class_ids
-        FROM exams e
-        LEFT JOIN exam_classes ec ON e.id = ec.exam_id
+	query := selectExamQuery + `
         WHERE e.id = $1
         GROUP BY e.id`
 
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&exam.ID,
-		&exam.Title,
-		&exam.Subject,
-		&exam.DurationMins,
-		&exam.TotalQuestions,
-		&exam.IsRandom,
-		&exam.TeacherID,
-		&exam.Status,
-		&exam.StartTime,
-		&exam.EndTime,
-		&exam.CreatedAt,
-		&exam.UpdatedAt,
-		pq.Array(&exam.ClassIDs),
-	)
-
+	exam, err := scanExam(r.db.QueryRowContext(ctx, query, id))
 	if err == sql.ErrNoRows {
 		return nil, repository.ErrExamNotFound
 	}
@@ -102,50 +153,116 @@ func (r *postgresRepository) GetByID(ctx context.Context, id string) (*domain.Ex
 	return exam, nil
 }
 
-func (r *postgresRepository) List(ctx context.Context, teacherID string, limit int32, offset int32) ([]*domain.Exam, error) {
-	query := `
-        SELECT e.id, e.title, e.subject, e.duration_mins, e.total_questions, 
-               e.is_random, e.teacher_id, e.status, e.start_time, e.end_time,
-               e.created_at, e.updated_at,
-               array_agg(ec.class_id) as class_ids
-        FROM exams e
-        LEFT JOIN exam_classes ec ON e.id = ec.exam_id
-        WHERE e.teacher_id = $1
+// examSortKeys adalah ekspresi urutan untuk setiap ExamSort. start_time NULL
+// disamakan dengan time.Time kosong agar cocok dengan nilai di cursor.
+var examSortKeys = map[domain.ExamSort]string{
+	domain.ExamSortCreatedAt: "e.created_at",
+	domain.ExamSortStartTime: "COALESCE(e.start_time, '0001-01-01 00:00:00+00'::timestamptz)",
+	domain.ExamSortTitle:     "e.title",
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// examConditions menyusun klausa WHERE untuk filter beserta argumennya
+func examConditions(filter domain.ExamFilter) ([]string, []interface{}) {
+	var conds []string
+	var args []interface{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter.TeacherID != "" {
+		conds = append(conds, "e.teacher_id = "+arg(filter.TeacherID))
+	}
+	if filter.Subject != "" {
+		conds = append(conds, "e.subject = "+arg(filter.Subject))
+	}
+	if filter.Status != "" {
+		conds = append(conds, "e.status = "+arg(filter.Status))
+	}
+	if filter.ClassID != "" {
+		conds = append(conds, "EXISTS (SELECT 1 FROM exam_classes fc WHERE fc.exam_id = e.id AND fc.class_id = "+arg(filter.ClassID)+")")
+	}
+	if !filter.StartFrom.IsZero() {
+		conds = append(conds, "e.start_time >= "+arg(filter.StartFrom))
+	}
+	if !filter.StartTo.IsZero() {
+		conds = append(conds, "e.start_time < "+arg(filter.StartTo))
+	}
+	if filter.Search != "" {
+		conds = append(conds, "e.title ILIKE '%' || "+arg(likeEscaper.Replace(filter.Search))+" || '%'")
+	}
+
+	return conds, args
+}
+
+func whereClause(conds []string) string {
+	if len(conds) == 0 {
+		return ""
+	}
+	return "\n        WHERE " + strings.Join(conds, "\n          AND ")
+}
+
+// List mengembalikan ujian sesuai filter dan urutannya, mulai setelah cursor after
+func (r *postgresRepository) List(ctx context.Context, filter domain.ExamFilter, limit int32, after *pagination.Cursor) ([]*domain.Exam, error) {
+	conds, args := examConditions(filter)
+
+	sortKey, ok := examSortKeys[filter.Sort]
+	if !ok {
+		sortKey = examSortKeys[domain.ExamSortCreatedAt]
+	}
+	direction, compare := "ASC", ">"
+	if filter.Descending {
+		direction, compare = "DESC", "<"
+	}
+
+	if after != nil {
+		var key interface{} = after.Time
+		if filter.Sort == domain.ExamSortTitle {
+			key = after.Value
+		}
+		args = append(args, key, after.ID)
+		conds = append(conds, fmt.Sprintf("(%s, e.id) %s ($%d, $%d::uuid)", sortKey, compare, len(args)-1, len(args)))
+	}
+
+	args = append(args, limit)
+	query := selectExamQuery + whereClause(conds) + fmt.Sprintf(`
         GROUP BY e.id
-        ORDER BY e.created_at DESC
-        LIMIT $2 OFFSET $3`
+        ORDER BY %s %s, e.id %s
+        LIMIT $%d`, sortKey, direction, direction, len(args))
 
-	rows, err := r.db.QueryContext(ctx, query, teacherID, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to list exams")
 	}
 	defer rows.Close()
 
+	return scanExams(rows)
+}
+
+func (r *postgresRepository) Count(ctx context.Context, filter domain.ExamFilter) (int32, error) {
+	conds, args := examConditions(filter)
+
+	var count int32
+	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM exams e"+whereClause(conds), args...).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to count exams")
+	}
+	return count, nil
+}
+
+func scanExams(rows *sql.Rows) ([]*domain.Exam, error) {
 	var exams []*domain.Exam
 	for rows.Next() {
-		exam := &domain.Exam{}
-		err := rows.Scan(
-			&exam.ID,
-			&exam.Title,
-			&exam.Subject,
-			&exam.DurationMins,
-			&exam.TotalQuestions,
-			&exam.IsRandom,
-			&exam.TeacherID,
-			&exam.Status,
-			&exam.StartTime,
-			&exam.EndTime,
-			&exam.CreatedAt,
-			&exam.UpdatedAt,
-			pq.Array(&exam.ClassIDs),
-		)
+		exam, err := scanExam(rows)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to scan exam row")
 		}
 		exams = append(exams, exam)
 	}
 
-	return exams, nil
+	return exams, rows.Err()
 }
 
 func (r *postgresRepository) Update(ctx context.Context, exam *domain.Exam) error {
@@ -158,8 +275,11 @@ func (r *postgresRepository) Update(ctx context.Context, exam *domain.Exam) erro
 	query := `
         UPDATE exams 
         SET title = $1, subject = $2, duration_mins = $3, total_questions = $4,
-            is_random = $5, status = $6, updated_at = CURRENT_TIMESTAMP
-        WHERE id = $7
+            is_random = $5, status = $6, scheduled_start_time = $7, scheduled_end_time = $8,
+            shuffle_choices = $9, score_policy = $10, score_max = $11,
+            penalty_type = $12, penalty_value = $13, allow_negative_score = $14,
+            updated_at = CURRENT_TIMESTAMP
+        WHERE id = $15
         RETURNING updated_at`
 
 	err = tx.QueryRowContext(
@@ -171,6 +291,14 @@ func (r *postgresRepository) Update(ctx context.Context, exam *domain.Exam) erro
 		exam.TotalQuestions,
 		exam.IsRandom,
 		exam.Status,
+		nullTime(exam.ScheduledStartTime),
+		nullTime(exam.ScheduledEndTime),
+		exam.ShuffleChoices,
+		exam.ScorePolicy,
+		exam.ScoreMax,
+		exam.PenaltyType,
+		exam.PenaltyValue,
+		exam.AllowNegativeScore,
 		exam.ID,
 	).Scan(&exam.UpdatedAt)
 
@@ -250,6 +378,121 @@ func (r *postgresRepository) UpdateStatus(ctx context.Context, examID string, st
 	return nil
 }
 
+// TransitionStatus hanya mengubah status jika ujian masih berada pada status from,
+// sehingga scheduler tidak menimpa perubahan manual yang terjadi bersamaan
+func (r *postgresRepository) TransitionStatus(ctx context.Context, examID string, from, to domain.ExamState) error {
+	query := `
+        UPDATE exams 
+        SET status = $1, updated_at = CURRENT_TIMESTAMP,
+            start_time = CASE 
+                WHEN $1 = 'ACTIVE' THEN CURRENT_TIMESTAMP 
+                ELSE start_time 
+            END,
+            end_time = CASE 
+                WHEN $1 = 'FINISHED' THEN CURRENT_TIMESTAMP 
+                ELSE end_time 
+            END
+        WHERE id = $2 AND status = $3`
+
+	result, err := r.db.ExecContext(ctx, query, to, examID, from)
+	if err != nil {
+		return errors.Wrap(err, "failed to transition exam status")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrInvalidExamState
+	}
+
+	return nil
+}
+
+// Activate mengganti kelas peserta dengan exam.ClassIDs dan membuka ujian yang
+// masih CREATED dalam satu transaksi, sehingga kelas tidak berubah jika
+// aktivasi gagal
+func (r *postgresRepository) Activate(ctx context.Context, exam *domain.Exam) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM exam_classes WHERE exam_id = $1", exam.ID)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete old exam classes")
+	}
+
+	if len(exam.ClassIDs) > 0 {
+		classQuery := `
+            INSERT INTO exam_classes (exam_id, class_id)
+            VALUES ($1, unnest($2::uuid[]))`
+
+		_, err = tx.ExecContext(ctx, classQuery, exam.ID, pq.Array(exam.ClassIDs))
+		if err != nil {
+			return errors.Wrap(err, "failed to insert new exam classes")
+		}
+	}
+
+	query := `
+        UPDATE exams 
+        SET status = 'ACTIVE', start_time = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+        WHERE id = $1 AND status = 'CREATED'
+        RETURNING start_time, updated_at`
+
+	err = tx.QueryRowContext(ctx, query, exam.ID).Scan(&exam.StartTime, &exam.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return repository.ErrInvalidExamState
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to activate exam")
+	}
+
+	exam.Status = domain.ExamStateActive
+	return tx.Commit()
+}
+
+// ListDueForActivation melewati ujian tanpa kelas peserta; ujian seperti itu
+// tidak bisa diaktifkan dan akan ditolak ulang oleh scheduler setiap putaran
+func (r *postgresRepository) ListDueForActivation(ctx context.Context, now time.Time) ([]*domain.Exam, error) {
+	query := selectExamQuery + `
+        WHERE e.status = 'CREATED'
+          AND e.scheduled_start_time <= $1
+          AND (e.scheduled_end_time IS NULL OR e.scheduled_end_time > $1)
+          AND EXISTS (SELECT 1 FROM exam_classes dc WHERE dc.exam_id = e.id)
+        GROUP BY e.id
+        ORDER BY e.scheduled_start_time`
+
+	rows, err := r.db.QueryContext(ctx, query, now)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list exams due for activation")
+	}
+	defer rows.Close()
+
+	return scanExams(rows)
+}
+
+// ListDueForFinish juga mengembalikan ujian CREATED yang jendelanya sudah lewat,
+// misalnya ketika service mati selama seluruh jadwal ujian
+func (r *postgresRepository) ListDueForFinish(ctx context.Context, now time.Time) ([]*domain.Exam, error) {
+	query := selectExamQuery + `
+        WHERE e.status IN ('CREATED', 'ACTIVE')
+          AND e.scheduled_end_time <= $1
+        GROUP BY e.id
+        ORDER BY e.scheduled_end_time`
+
+	rows, err := r.db.QueryContext(ctx, query, now)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list exams due for finish")
+	}
+	defer rows.Close()
+
+	return scanExams(rows)
+}
+
 func (r *postgresRepository) GetStatus(ctx context.Context, examID string) (*domain.ExamStatus, error) {
 	status := &domain.ExamStatus{
 		ExamID: examID,
@@ -260,7 +503,7 @@ func (r *postgresRepository) GetStatus(ctx context.Context, examID string) (*dom
         SELECT e.status,
                COUNT(DISTINCT es.student_id) as total_students,
                COUNT(CASE WHEN es.state != 'NOT_STARTED' THEN 1 END) as students_started,
-               COUNT(CASE WHEN es.state = 'FINISHED' THEN 1 END) as students_finished
+               COUNT(CASE WHEN es.state IN ('FINISHED', 'TIMED_OUT') THEN 1 END) as students_finished
         FROM exams e
         LEFT JOIN exam_student_status es ON e.id = es.exam_id
         WHERE e.id = $1
@@ -280,12 +523,17 @@ func (r *postgresRepository) GetStatus(ctx context.Context, examID string) (*dom
 		return nil, errors.Wrap(err, "failed to get exam status")
 	}
 
-	// Get student statuses
+	// Get student statuses with the progress of their sessions
 	studentQuery := `
-        SELECT student_id, student_name, class_id, state, start_time, end_time
-        FROM exam_student_status
-        WHERE exam_id = $1
-        ORDER BY created_at`
+        SELECT ess.student_id, ess.student_name, ess.class_id, ess.state, ess.start_time, ess.end_time,
+               COALESCE(es.id::text, ''),
+               (SELECT COUNT(*) FROM session_answers sa
+                WHERE sa.session_id = es.id AND (sa.selected_choice <> '' OR sa.answer_text <> '')),
+               (SELECT COUNT(*) FROM session_questions sq WHERE sq.session_id = es.id)
+        FROM exam_student_status ess
+        LEFT JOIN exam_sessions es ON es.exam_id = ess.exam_id AND es.student_id = ess.student_id
+        WHERE ess.exam_id = $1
+        ORDER BY ess.created_at, ess.student_id`
 
 	rows, err := r.db.QueryContext(ctx, studentQuery, examID)
 	if err != nil {
@@ -295,17 +543,23 @@ func (r *postgresRepository) GetStatus(ctx context.Context, examID string) (*dom
 
 	for rows.Next() {
 		var studentStatus domain.StudentStatus
+		var startTime, endTime sql.NullTime
 		err := rows.Scan(
 			&studentStatus.StudentID,
 			&studentStatus.StudentName,
 			&studentStatus.ClassID,
 			&studentStatus.State,
-			&studentStatus.StartTime,
-			&studentStatus.EndTime,
+			&startTime,
+			&endTime,
+			&studentStatus.SessionID,
+			&studentStatus.Answered,
+			&studentStatus.TotalQuestions,
 		)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to scan student status")
 		}
+		studentStatus.StartTime = startTime.Time
+		studentStatus.EndTime = endTime.Time
 		status.StudentStatuses = append(status.StudentStatuses, studentStatus)
 	}
 
@@ -332,8 +586,8 @@ func (r *postgresRepository) UpdateStudentStatus(ctx context.Context, examID str
 		studentStatus.StudentName,
 		studentStatus.ClassID,
 		studentStatus.State,
-		studentStatus.StartTime,
-		studentStatus.EndTime,
+		nullTime(studentStatus.StartTime),
+		nullTime(studentStatus.EndTime),
 	)
 	if err != nil {
 		return errors.Wrap(err, "failed to update student status")
@@ -350,3 +604,35 @@ func (r *postgresRepository) UpdateStudentStatus(ctx context.Context, examID str
 
 	return nil
 }
+
+// SeedStudentStatuses mencatat siswa yang belum tercatat pada ujian; siswa yang
+// sudah ada (termasuk yang terdaftar di lebih dari satu kelas) dibiarkan
+func (r *postgresRepository) SeedStudentStatuses(ctx context.Context, examID string, students []domain.StudentStatus) error {
+	if len(students) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, `
+        INSERT INTO exam_student_status (exam_id, student_id, student_name, class_id, state)
+        VALUES ($1, $2, $3, $4, $5)
+        ON CONFLICT (exam_id, student_id) DO NOTHING`)
+	if err != nil {
+		return errors.Wrap(err, "failed to prepare student status insert")
+	}
+	defer stmt.Close()
+
+	for _, s := range students {
+		_, err := stmt.ExecContext(ctx, examID, s.StudentID, s.StudentName, s.ClassID, s.State)
+		if err != nil {
+			return errors.Wrap(err, "failed to seed student status")
+		}
+	}
+
+	return tx.Commit()
+}
@@ -1,5 +1,10 @@
+-- Untuk pencarian judul ujian (ILIKE '%...%')
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
 CREATE TYPE exam_state AS ENUM ('CREATED', 'ACTIVE', 'FINISHED');
-CREATE TYPE exam_student_state AS ENUM ('NOT_STARTED', 'IN_PROGRESS', 'FINISHED');
+CREATE TYPE exam_student_state AS ENUM ('NOT_STARTED', 'IN_PROGRESS', 'FINISHED', 'TIMED_OUT');
+CREATE TYPE score_policy AS ENUM ('PERCENTAGE', 'RAW_POINTS', 'SCALED');
+CREATE TYPE penalty_type AS ENUM ('NONE', 'FIXED', 'FRACTION');
 
 CREATE TABLE exams (
                        id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
@@ -8,10 +13,20 @@ CREATE TABLE exams (
                        duration_mins INTEGER NOT NULL,
                        total_questions INTEGER NOT NULL,
                        is_random BOOLEAN DEFAULT false,
+                       shuffle_choices BOOLEAN NOT NULL DEFAULT false,
+                       score_policy score_policy NOT NULL DEFAULT 'PERCENTAGE',
+                       -- Nilai maksimal untuk score_policy SCALED
+                       score_max DECIMAL(8,2) NOT NULL DEFAULT 100,
+                       -- Pengurangan poin untuk jawaban salah
+                       penalty_type penalty_type NOT NULL DEFAULT 'NONE',
+                       penalty_value DECIMAL(6,2) NOT NULL DEFAULT 0,
+                       allow_negative_score BOOLEAN NOT NULL DEFAULT false,
                        teacher_id UUID NOT NULL,
                        status exam_state NOT NULL DEFAULT 'CREATED',
                        start_time TIMESTAMP WITH TIME ZONE,
                        end_time TIMESTAMP WITH TIME ZONE,
+                       scheduled_start_time TIMESTAMP WITH TIME ZONE,
+                       scheduled_end_time TIMESTAMP WITH TIME ZONE,
                        created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
                        updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
 );
@@ -36,7 +51,13 @@ CREATE TABLE exam_student_status (
                                      UNIQUE (exam_id, student_id)
 );
 
-CREATE INDEX idx_exam_teacher ON exams(teacher_id);
+CREATE INDEX idx_exam_teacher ON exams(teacher_id, created_at DESC, id DESC);
 CREATE INDEX idx_exam_status ON exams(status);
+CREATE INDEX idx_exam_subject ON exams(subject);
+CREATE INDEX idx_exam_start_time ON exams(start_time);
+CREATE INDEX idx_exam_title_trgm ON exams USING gin (title gin_trgm_ops);
+CREATE INDEX idx_exam_classes_class ON exam_classes(class_id);
+CREATE INDEX idx_exam_scheduled_start ON exams(scheduled_start_time) WHERE status = 'CREATED';
+CREATE INDEX idx_exam_scheduled_end ON exams(scheduled_end_time) WHERE status IN ('CREATED', 'ACTIVE');
 CREATE INDEX idx_student_status_exam ON exam_student_status(exam_id);
 CREATE INDEX idx_student_status_student ON exam_student_status(student_id);
\ No newline at end of file
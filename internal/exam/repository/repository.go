@@ -3,22 +3,32 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/ApesJs/cbt-exam/internal/exam/domain"
+	"github.com/ApesJs/cbt-exam/pkg/pagination"
 )
 
 type ExamRepository interface {
 	// Exam operations
 	Create(ctx context.Context, exam *domain.Exam) error
 	GetByID(ctx context.Context, id string) (*domain.Exam, error)
-	List(ctx context.Context, teacherID string, limit int32, offset int32) ([]*domain.Exam, error)
+	List(ctx context.Context, filter domain.ExamFilter, limit int32, after *pagination.Cursor) ([]*domain.Exam, error)
+	Count(ctx context.Context, filter domain.ExamFilter) (int32, error)
 	Update(ctx context.Context, exam *domain.Exam) error
 	Delete(ctx context.Context, id string) error
 
 	// Exam status operations
 	UpdateStatus(ctx context.Context, examID string, status domain.ExamState) error
+	TransitionStatus(ctx context.Context, examID string, from, to domain.ExamState) error
+	Activate(ctx context.Context, exam *domain.Exam) error
 	GetStatus(ctx context.Context, examID string) (*domain.ExamStatus, error)
 	UpdateStudentStatus(ctx context.Context, examID string, studentStatus *domain.StudentStatus) error
+	SeedStudentStatuses(ctx context.Context, examID string, students []domain.StudentStatus) error
+
+	// Scheduled window operations
+	ListDueForActivation(ctx context.Context, now time.Time) ([]*domain.Exam, error)
+	ListDueForFinish(ctx context.Context, now time.Time) ([]*domain.Exam, error)
 }
 
 // Errors
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	userv1 "github.com/ApesJs/cbt-exam/api/proto/user/v1"
+	"github.com/ApesJs/cbt-exam/internal/exam/domain"
+	"github.com/ApesJs/cbt-exam/internal/exam/repository"
+	"github.com/ApesJs/cbt-exam/pkg/client"
+)
+
+// errNoClasses berarti ujian belum memiliki kelas peserta, sehingga tidak ada
+// siswa yang boleh memulai sesi
+var errNoClasses = errors.New("exam has no participating classes")
+
+// seedRoster mencatat setiap siswa kelas peserta sebagai NOT_STARTED agar
+// GetExamStatus juga menampilkan siswa yang belum mulai. Siswa yang sudah
+// tercatat tidak diubah, sehingga aman dipanggil berulang kali. Ujian tanpa
+// kelas peserta ditolak dengan errNoClasses.
+func seedRoster(ctx context.Context, repo repository.ExamRepository, client *client.ServiceClient, exam *domain.Exam) error {
+	if len(exam.ClassIDs) == 0 {
+		return errNoClasses
+	}
+
+	resp, err := client.ListClassStudents(ctx, &userv1.ListClassStudentsRequest{
+		ClassIds: exam.ClassIDs,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list class students")
+	}
+
+	students := make([]domain.StudentStatus, 0, len(resp.Students))
+	for _, cs := range resp.Students {
+		students = append(students, domain.StudentStatus{
+			StudentID:   cs.Student.Id,
+			StudentName: cs.Student.Name,
+			ClassID:     cs.ClassId,
+			State:       domain.ExamStudentStateNotStarted,
+		})
+	}
+
+	return repo.SeedStudentStatuses(ctx, exam.ID, students)
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ApesJs/cbt-exam/internal/exam/domain"
+	"github.com/ApesJs/cbt-exam/internal/exam/repository"
+	"github.com/ApesJs/cbt-exam/pkg/client"
+)
+
+// Scheduler membuka dan menutup ujian sesuai jendela yang direncanakan.
+// Seluruh state disimpan di tabel exams, sehingga setelah restart scheduler
+// langsung memproses transisi yang jatuh tempo selama service mati.
+type Scheduler struct {
+	repo     repository.ExamRepository
+	client   *client.ServiceClient
+	interval time.Duration
+}
+
+func NewScheduler(repo repository.ExamRepository, client *client.ServiceClient, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		repo:     repo,
+		client:   client,
+		interval: interval,
+	}
+}
+
+// Run memproses jadwal secara berkala sampai ctx dibatalkan
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) tick(ctx context.Context, now time.Time) {
+	// Tutup dulu ujian yang sudah lewat jadwal, baru buka ujian yang jatuh tempo
+	finished, err := s.repo.ListDueForFinish(ctx, now)
+	if err != nil {
+		log.Printf("scheduler: failed to list exams due for finish: %v", err)
+	}
+	for _, exam := range finished {
+		s.transition(ctx, exam, domain.ExamStateFinished)
+	}
+
+	activated, err := s.repo.ListDueForActivation(ctx, now)
+	if err != nil {
+		log.Printf("scheduler: failed to list exams due for activation: %v", err)
+	}
+	for _, exam := range activated {
+		// Seperti aktivasi manual, ujian baru dibuka setelah daftar peserta
+		// tercatat; jika gagal, dicoba lagi pada putaran berikutnya
+		if err := seedRoster(ctx, s.repo, s.client, exam); err != nil {
+			log.Printf("scheduler: failed to seed roster of exam %s: %v", exam.ID, err)
+			continue
+		}
+		s.transition(ctx, exam, domain.ExamStateActive)
+	}
+}
+
+func (s *Scheduler) transition(ctx context.Context, exam *domain.Exam, to domain.ExamState) {
+	err := s.repo.TransitionStatus(ctx, exam.ID, exam.Status, to)
+	if errors.Is(err, repository.ErrInvalidExamState) {
+		// Status sudah diubah oleh guru atau instance lain
+		return
+	}
+	if err != nil {
+		log.Printf("scheduler: failed to move exam %s from %s to %s: %v", exam.ID, exam.Status, to, err)
+		return
+	}
+
+	log.Printf("scheduler: exam %s moved from %s to %s", exam.ID, exam.Status, to)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -13,29 +15,51 @@ import (
 	examv1 "github.com/ApesJs/cbt-exam/api/proto/exam/v1"
 	"github.com/ApesJs/cbt-exam/internal/exam/domain"
 	"github.com/ApesJs/cbt-exam/internal/exam/repository"
+	"github.com/ApesJs/cbt-exam/pkg/client"
+	"github.com/ApesJs/cbt-exam/pkg/pagination"
 )
 
 type examService struct {
-	repo repository.ExamRepository
+	repo   repository.ExamRepository
+	client *client.ServiceClient
 	examv1.UnimplementedExamServiceServer
 }
 
-func NewExamService(repo repository.ExamRepository) examv1.ExamServiceServer {
+func NewExamService(repo repository.ExamRepository, client *client.ServiceClient) examv1.ExamServiceServer {
 	return &examService{
-		repo: repo,
+		repo:   repo,
+		client: client,
 	}
 }
 
 func (s *examService) CreateExam(ctx context.Context, req *examv1.CreateExamRequest) (*examv1.Exam, error) {
 	exam := &domain.Exam{
-		Title:          req.Title,
-		Subject:        req.Subject,
-		DurationMins:   req.DurationMinutes,
-		TotalQuestions: req.TotalQuestions,
-		IsRandom:       req.IsRandom,
-		TeacherID:      req.TeacherId,
-		ClassIDs:       req.ClassIds,
-		Status:         domain.ExamStateCreated,
+		Title:              req.Title,
+		Subject:            req.Subject,
+		DurationMins:       req.DurationMinutes,
+		TotalQuestions:     req.TotalQuestions,
+		IsRandom:           req.IsRandom,
+		ShuffleChoices:     req.ShuffleChoices,
+		ScorePolicy:        convertScorePolicyToDomain(req.ScorePolicy),
+		ScoreMax:           req.ScoreMax,
+		PenaltyType:        convertPenaltyTypeToDomain(req.PenaltyType),
+		PenaltyValue:       req.PenaltyValue,
+		AllowNegativeScore: req.AllowNegativeScore,
+		TeacherID:          req.TeacherId,
+		ClassIDs:           req.ClassIds,
+		Status:             domain.ExamStateCreated,
+		ScheduledStartTime: convertTimestamp(req.ScheduledStartTime),
+		ScheduledEndTime:   convertTimestamp(req.ScheduledEndTime),
+	}
+
+	if err := validateSchedule(exam); err != nil {
+		return nil, err
+	}
+	if err := validateScorePolicy(exam); err != nil {
+		return nil, err
+	}
+	if !exam.ScheduledEndTime.IsZero() && exam.ScheduledEndTime.Before(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "scheduled end time must be in the future")
 	}
 
 	if err := s.repo.Create(ctx, exam); err != nil {
@@ -58,26 +82,52 @@ func (s *examService) GetExam(ctx context.Context, req *examv1.GetExamRequest) (
 }
 
 func (s *examService) ListExams(ctx context.Context, req *examv1.ListExamsRequest) (*examv1.ListExamsResponse, error) {
-	exams, err := s.repo.List(ctx, req.TeacherId, req.PageSize, int32(len(req.PageToken)))
+	filter, err := convertFilterToDomain(req)
+	if err != nil {
+		return nil, err
+	}
+	order := string(filter.Sort) + ":" + strconv.FormatBool(filter.Descending)
+
+	after, err := pagination.Decode(req.PageToken)
+	if err == nil && after != nil && after.Order != order {
+		err = errors.New("page token does not match the sort order")
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Ambil satu baris lebih untuk mengetahui apakah masih ada halaman berikutnya
+	pageSize := pagination.PageSize(req.PageSize)
+	exams, err := s.repo.List(ctx, filter, pageSize+1, after)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list exams: %v", err)
 	}
 
-	var protoExams []*examv1.Exam
+	resp := &examv1.ListExamsResponse{}
+	if len(exams) > int(pageSize) {
+		exams = exams[:pageSize]
+		last := exams[len(exams)-1]
+		cursor := pagination.Cursor{Time: last.CreatedAt, ID: last.ID, Order: order}
+		switch filter.Sort {
+		case domain.ExamSortStartTime:
+			cursor.Time = last.StartTime
+		case domain.ExamSortTitle:
+			cursor.Time, cursor.Value = time.Time{}, last.Title
+		}
+		resp.NextPageToken = pagination.Encode(cursor)
+	}
 	for _, exam := range exams {
-		protoExams = append(protoExams, convertDomainToProto(exam))
+		resp.Exams = append(resp.Exams, convertDomainToProto(exam))
 	}
 
-	// Simple pagination - using the last exam's ID as the next page token
-	var nextPageToken string
-	if len(exams) == int(req.PageSize) {
-		nextPageToken = exams[len(exams)-1].ID
+	if req.IncludeTotal {
+		resp.TotalSize, err = s.repo.Count(ctx, filter)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to count exams: %v", err)
+		}
 	}
 
-	return &examv1.ListExamsResponse{
-		Exams:         protoExams,
-		NextPageToken: nextPageToken,
-	}, nil
+	return resp, nil
 }
 
 func (s *examService) UpdateExam(ctx context.Context, req *examv1.UpdateExamRequest) (*examv1.Exam, error) {
@@ -88,8 +138,25 @@ func (s *examService) UpdateExam(ctx context.Context, req *examv1.UpdateExamRequ
 		DurationMins:   req.Exam.DurationMinutes,
 		TotalQuestions: req.Exam.TotalQuestions,
 		IsRandom:       req.Exam.IsRandom,
+		ShuffleChoices: req.Exam.ShuffleChoices,
+		ScorePolicy:    convertScorePolicyToDomain(req.Exam.ScorePolicy),
+		ScoreMax:       req.Exam.ScoreMax,
 		TeacherID:      req.Exam.TeacherId,
 		ClassIDs:       req.Exam.ClassIds,
+
+		PenaltyType:        convertPenaltyTypeToDomain(req.Exam.PenaltyType),
+		PenaltyValue:       req.Exam.PenaltyValue,
+		AllowNegativeScore: req.Exam.AllowNegativeScore,
+
+		ScheduledStartTime: convertTimestamp(req.Exam.ScheduledStartTime),
+		ScheduledEndTime:   convertTimestamp(req.Exam.ScheduledEndTime),
+	}
+
+	if err := validateSchedule(exam); err != nil {
+		return nil, err
+	}
+	if err := validateScorePolicy(exam); err != nil {
+		return nil, err
 	}
 
 	if err := s.repo.Update(ctx, exam); err != nil {
@@ -126,10 +193,24 @@ func (s *examService) ActivateExam(ctx context.Context, req *examv1.ActivateExam
 		return nil, status.Error(codes.FailedPrecondition, "exam can only be activated when in CREATED state")
 	}
 
-	exam.Status = domain.ExamStateActive
-	exam.StartTime = time.Now()
+	// Kelas peserta pada permintaan aktivasi menggantikan kelas ujian; keduanya
+	// disimpan bersama status ACTIVE oleh repo.Activate
+	if len(req.ClassIds) > 0 {
+		exam.ClassIDs = req.ClassIds
+	}
+
+	// Daftar peserta dicatat sebelum ujian dibuka; aman diulang jika aktivasi gagal
+	if err := seedRoster(ctx, s.repo, s.client, exam); err != nil {
+		if errors.Is(err, errNoClasses) {
+			return nil, status.Error(codes.FailedPrecondition, "exam has no participating classes; add class_ids before activating")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to seed exam roster: %v", err)
+	}
 
-	if err := s.repo.UpdateStatus(ctx, req.Id, domain.ExamStateActive); err != nil {
+	if err := s.repo.Activate(ctx, exam); err != nil {
+		if errors.Is(err, repository.ErrInvalidExamState) {
+			return nil, status.Error(codes.FailedPrecondition, "exam can only be activated when in CREATED state")
+		}
 		return nil, status.Errorf(codes.Internal, "failed to activate exam: %v", err)
 	}
 
@@ -171,17 +252,58 @@ func (s *examService) GetExamStatus(ctx context.Context, req *examv1.GetExamStat
 	return convertStatusToProto(getStatus), nil
 }
 
+// validateSchedule memastikan jendela ujian yang direncanakan masuk akal
+func validateSchedule(exam *domain.Exam) error {
+	if !exam.ScheduledStartTime.IsZero() && !exam.ScheduledEndTime.IsZero() &&
+		!exam.ScheduledEndTime.After(exam.ScheduledStartTime) {
+		return status.Error(codes.InvalidArgument, "scheduled end time must be after scheduled start time")
+	}
+	return nil
+}
+
+// validateScorePolicy memastikan ScoreMax dan aturan penalti valid; ScoreMax 0
+// berarti skala default 100
+func validateScorePolicy(exam *domain.Exam) error {
+	if exam.ScoreMax < 0 {
+		return status.Error(codes.InvalidArgument, "score max must not be negative")
+	}
+	if exam.ScoreMax == 0 {
+		exam.ScoreMax = 100
+	}
+
+	switch exam.PenaltyType {
+	case domain.PenaltyNone:
+		exam.PenaltyValue = 0
+	case domain.PenaltyFixed, domain.PenaltyFraction:
+		if exam.PenaltyValue <= 0 {
+			return status.Error(codes.InvalidArgument, "penalty value must be positive")
+		}
+		if exam.PenaltyType == domain.PenaltyFraction && exam.PenaltyValue > 1 {
+			return status.Error(codes.InvalidArgument, "fraction penalty must not exceed 1")
+		}
+	}
+	return nil
+}
+
 // Helper functions to convert between domain and proto models
 func convertDomainToProto(exam *domain.Exam) *examv1.Exam {
-	return &examv1.Exam{
+	protoExam := &examv1.Exam{
 		Id:              exam.ID,
 		Title:           exam.Title,
 		Subject:         exam.Subject,
 		DurationMinutes: exam.DurationMins,
 		TotalQuestions:  exam.TotalQuestions,
 		IsRandom:        exam.IsRandom,
+		ShuffleChoices:  exam.ShuffleChoices,
+		ScorePolicy:     convertScorePolicyToProto(exam.ScorePolicy),
+		ScoreMax:        exam.ScoreMax,
 		TeacherId:       exam.TeacherID,
 		ClassIds:        exam.ClassIDs,
+
+		PenaltyType:        convertPenaltyTypeToProto(exam.PenaltyType),
+		PenaltyValue:       exam.PenaltyValue,
+		AllowNegativeScore: exam.AllowNegativeScore,
+
 		Status: &examv1.ExamStatus{
 			State: convertExamState(exam.Status),
 		},
@@ -190,18 +312,83 @@ func convertDomainToProto(exam *domain.Exam) *examv1.Exam {
 		CreatedAt: timestamppb.New(exam.CreatedAt),
 		UpdatedAt: timestamppb.New(exam.UpdatedAt),
 	}
+
+	if !exam.ScheduledStartTime.IsZero() {
+		protoExam.ScheduledStartTime = timestamppb.New(exam.ScheduledStartTime)
+	}
+	if !exam.ScheduledEndTime.IsZero() {
+		protoExam.ScheduledEndTime = timestamppb.New(exam.ScheduledEndTime)
+	}
+
+	return protoExam
+}
+
+func convertFilterToDomain(req *examv1.ListExamsRequest) (domain.ExamFilter, error) {
+	filter := domain.ExamFilter{
+		TeacherID: req.TeacherId,
+		Subject:   req.Subject,
+		Status:    convertExamStateToDomain(req.Status),
+		ClassID:   req.ClassId,
+		StartFrom: convertTimestamp(req.StartFrom),
+		StartTo:   convertTimestamp(req.StartTo),
+		Search:    strings.TrimSpace(req.Query),
+	}
+
+	if !filter.StartFrom.IsZero() && !filter.StartTo.IsZero() && !filter.StartTo.After(filter.StartFrom) {
+		return filter, status.Error(codes.InvalidArgument, "start_to must be after start_from")
+	}
+
+	switch req.SortBy {
+	case examv1.ExamSortField_EXAM_SORT_FIELD_UNSPECIFIED, examv1.ExamSortField_EXAM_SORT_FIELD_CREATED_AT:
+		filter.Sort = domain.ExamSortCreatedAt
+	case examv1.ExamSortField_EXAM_SORT_FIELD_START_TIME:
+		filter.Sort = domain.ExamSortStartTime
+	case examv1.ExamSortField_EXAM_SORT_FIELD_TITLE:
+		filter.Sort = domain.ExamSortTitle
+	default:
+		return filter, status.Error(codes.InvalidArgument, "invalid sort field")
+	}
+
+	switch req.SortDirection {
+	case examv1.SortDirection_SORT_DIRECTION_ASC:
+		filter.Descending = false
+	case examv1.SortDirection_SORT_DIRECTION_DESC:
+		filter.Descending = true
+	default:
+		filter.Descending = filter.Sort != domain.ExamSortTitle
+	}
+
+	return filter, nil
+}
+
+// optionalTimestamp mengembalikan nil untuk waktu yang belum terjadi
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func convertTimestamp(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
 }
 
 func convertStatusToProto(status *domain.ExamStatus) *examv1.ExamStatus {
 	var studentStatuses []*examv1.StudentStatus
 	for _, s := range status.StudentStatuses {
 		studentStatuses = append(studentStatuses, &examv1.StudentStatus{
-			StudentId:   s.StudentID,
-			StudentName: s.StudentName,
-			ClassId:     s.ClassID,
-			State:       convertStudentState(s.State),
-			StartTime:   timestamppb.New(s.StartTime),
-			EndTime:     timestamppb.New(s.EndTime),
+			StudentId:      s.StudentID,
+			StudentName:    s.StudentName,
+			ClassId:        s.ClassID,
+			State:          convertStudentState(s.State),
+			StartTime:      optionalTimestamp(s.StartTime),
+			EndTime:        optionalTimestamp(s.EndTime),
+			SessionId:      s.SessionID,
+			Answered:       s.Answered,
+			TotalQuestions: s.TotalQuestions,
 		})
 	}
 
@@ -229,6 +416,19 @@ func convertExamState(state domain.ExamState) examv1.ExamState {
 	}
 }
 
+func convertExamStateToDomain(state examv1.ExamState) domain.ExamState {
+	switch state {
+	case examv1.ExamState_EXAM_STATE_CREATED:
+		return domain.ExamStateCreated
+	case examv1.ExamState_EXAM_STATE_ACTIVE:
+		return domain.ExamStateActive
+	case examv1.ExamState_EXAM_STATE_FINISHED:
+		return domain.ExamStateFinished
+	default:
+		return ""
+	}
+}
+
 func convertStudentState(state domain.ExamStudentState) examv1.ExamStudentState {
 	switch state {
 	case domain.ExamStudentStateNotStarted:
@@ -237,7 +437,59 @@ func convertStudentState(state domain.ExamStudentState) examv1.ExamStudentState
 		return examv1.ExamStudentState_EXAM_STUDENT_STATE_IN_PROGRESS
 	case domain.ExamStudentStateFinished:
 		return examv1.ExamStudentState_EXAM_STUDENT_STATE_FINISHED
+	case domain.ExamStudentStateTimedOut:
+		return examv1.ExamStudentState_EXAM_STUDENT_STATE_TIMED_OUT
 	default:
 		return examv1.ExamStudentState_EXAM_STUDENT_STATE_UNSPECIFIED
 	}
 }
+
+func convertScorePolicyToProto(policy domain.ScorePolicy) examv1.ScorePolicy {
+	switch policy {
+	case domain.ScorePolicyPercentage:
+		return examv1.ScorePolicy_SCORE_POLICY_PERCENTAGE
+	case domain.ScorePolicyRawPoints:
+		return examv1.ScorePolicy_SCORE_POLICY_RAW_POINTS
+	case domain.ScorePolicyScaled:
+		return examv1.ScorePolicy_SCORE_POLICY_SCALED
+	default:
+		return examv1.ScorePolicy_SCORE_POLICY_UNSPECIFIED
+	}
+}
+
+// convertScorePolicyToDomain memetakan kebijakan nilai; UNSPECIFIED berarti PERCENTAGE
+func convertScorePolicyToDomain(policy examv1.ScorePolicy) domain.ScorePolicy {
+	switch policy {
+	case examv1.ScorePolicy_SCORE_POLICY_RAW_POINTS:
+		return domain.ScorePolicyRawPoints
+	case examv1.ScorePolicy_SCORE_POLICY_SCALED:
+		return domain.ScorePolicyScaled
+	default:
+		return domain.ScorePolicyPercentage
+	}
+}
+
+func convertPenaltyTypeToProto(penalty domain.PenaltyType) examv1.PenaltyType {
+	switch penalty {
+	case domain.PenaltyNone:
+		return examv1.PenaltyType_PENALTY_TYPE_NONE
+	case domain.PenaltyFixed:
+		return examv1.PenaltyType_PENALTY_TYPE_FIXED
+	case domain.PenaltyFraction:
+		return examv1.PenaltyType_PENALTY_TYPE_FRACTION
+	default:
+		return examv1.PenaltyType_PENALTY_TYPE_UNSPECIFIED
+	}
+}
+
+// convertPenaltyTypeToDomain memetakan aturan penalti; UNSPECIFIED berarti tanpa penalti
+func convertPenaltyTypeToDomain(penalty examv1.PenaltyType) domain.PenaltyType {
+	switch penalty {
+	case examv1.PenaltyType_PENALTY_TYPE_FIXED:
+		return domain.PenaltyFixed
+	case examv1.PenaltyType_PENALTY_TYPE_FRACTION:
+		return domain.PenaltyFraction
+	default:
+		return domain.PenaltyNone
+	}
+}
@@ -1,11 +1,16 @@
 package handler
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	examv1 "github.com/ApesJs/cbt-exam/api/proto/exam/v1"
 	"github.com/ApesJs/cbt-exam/pkg/client"
@@ -132,12 +137,62 @@ func (h *ExamHandler) DeleteExam(c *gin.Context) {
 func (h *ExamHandler) ListExams(c *gin.Context) {
 	teacherID := c.Query("teacherId")
 	pageSize := 10 // default page size
+	if size := c.Query("pageSize"); size != "" {
+		if s, err := strconv.Atoi(size); err == nil {
+			pageSize = s
+		}
+	}
 	pageToken := c.Query("pageToken")
+	includeTotal, _ := strconv.ParseBool(c.Query("includeTotal"))
 
 	req := &examv1.ListExamsRequest{
-		TeacherId: teacherID,
-		PageSize:  int32(pageSize),
-		PageToken: pageToken,
+		TeacherId:    teacherID,
+		PageSize:     int32(pageSize),
+		PageToken:    pageToken,
+		IncludeTotal: includeTotal,
+		Subject:      c.Query("subject"),
+		ClassId:      c.Query("classId"),
+		Query:        c.Query("q"),
+	}
+
+	// Filter status ujian, misal ?status=ACTIVE
+	if s := c.Query("status"); s != "" {
+		value, ok := examv1.ExamState_value["EXAM_STATE_"+strings.ToUpper(s)]
+		if !ok {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "status must be CREATED, ACTIVE or FINISHED"})
+			return
+		}
+		req.Status = examv1.ExamState(value)
+	}
+
+	// Rentang waktu mulai dalam format RFC3339
+	for param, dst := range map[string]**timestamppb.Timestamp{"startFrom": &req.StartFrom, "startTo": &req.StartTo} {
+		if s := c.Query(param); s != "" {
+			t, err := time.Parse(time.RFC3339, s)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": param + " must be an RFC3339 timestamp"})
+				return
+			}
+			*dst = timestamppb.New(t)
+		}
+	}
+
+	// Urutan, misal ?sortBy=title&sortDir=asc
+	if s := c.Query("sortBy"); s != "" {
+		value, ok := examv1.ExamSortField_value["EXAM_SORT_FIELD_"+strings.ToUpper(s)]
+		if !ok {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "sortBy must be created_at, start_time or title"})
+			return
+		}
+		req.SortBy = examv1.ExamSortField(value)
+	}
+	if s := c.Query("sortDir"); s != "" {
+		value, ok := examv1.SortDirection_value["SORT_DIRECTION_"+strings.ToUpper(s)]
+		if !ok {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "sortDir must be asc or desc"})
+			return
+		}
+		req.SortDirection = examv1.SortDirection(value)
 	}
 
 	resp, err := h.client.ListExams(c.Request.Context(), req)
@@ -147,6 +202,12 @@ func (h *ExamHandler) ListExams(c *gin.Context) {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
+
+		if st.Code() == codes.InvalidArgument {
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+			return
+		}
+
 		c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
 		return
 	}
@@ -210,3 +271,180 @@ func (h *ExamHandler) DeactivateExam(c *gin.Context) {
 
 	c.JSON(http.StatusOK, exam)
 }
+
+func (h *ExamHandler) GetExamStatus(c *gin.Context) {
+	examStatus, err := h.client.GetExamStatus(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		writeExamStatusError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, examStatus)
+}
+
+func writeExamStatusError(c *gin.Context, err error) {
+	st, ok := status.FromError(err)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if st.Code() == codes.NotFound {
+		c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+}
+
+const (
+	defaultWatchInterval = 3 * time.Second
+	// watchKeepAlive adalah jeda maksimal tanpa data sebelum dikirim komentar
+	// agar koneksi tidak diputus proxy
+	watchKeepAlive = 15 * time.Second
+)
+
+// WatchExamStatus mengirim perubahan status peserta ujian sebagai server-sent
+// events. Event pertama "snapshot" berisi status lengkap, lalu satu event per
+// perubahan siswa: "joined", "started", "answered", "finished" atau
+// "timed_out", dan "summary" ketika jumlah peserta atau status ujian berubah.
+// Stream ditutup dengan event "end" setelah ujian selesai dan tidak ada siswa
+// yang masih mengerjakan. Status dibaca ulang setiap ?interval= detik (1-60).
+func (h *ExamHandler) WatchExamStatus(c *gin.Context) {
+	id := c.Param("id")
+	interval := defaultWatchInterval
+	if s := c.Query("interval"); s != "" {
+		seconds, err := strconv.Atoi(s)
+		if err != nil || seconds < 1 || seconds > 60 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "interval must be between 1 and 60 seconds"})
+			return
+		}
+		interval = time.Duration(seconds) * time.Second
+	}
+
+	ctx := c.Request.Context()
+
+	// Status pertama dibaca sebelum header dikirim agar error masih bisa
+	// dikembalikan sebagai status HTTP
+	current, err := h.client.GetExamStatus(ctx, id)
+	if err != nil {
+		writeExamStatusError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.SSEvent("snapshot", current)
+	c.Writer.Flush()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	lastWrite := time.Now()
+
+	for {
+		if examStatusDone(current) {
+			c.SSEvent("end", examStatusSummary(current))
+			c.Writer.Flush()
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		next, err := h.client.GetExamStatus(ctx, id)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			// Klien EventSource akan tersambung ulang dan menerima snapshot baru
+			c.SSEvent("error", gin.H{"error": status.Convert(err).Message()})
+			c.Writer.Flush()
+			return
+		}
+
+		events := diffExamStatus(current, next)
+		for _, e := range events {
+			c.SSEvent(e.name, e.data)
+		}
+		if len(events) > 0 {
+			lastWrite = time.Now()
+		} else if time.Since(lastWrite) >= watchKeepAlive {
+			fmt.Fprint(c.Writer, ": keep-alive\n\n")
+			lastWrite = time.Now()
+		}
+		c.Writer.Flush()
+
+		current = next
+	}
+}
+
+type examStatusEvent struct {
+	name string
+	data interface{}
+}
+
+func examStatusSummary(s *examv1.ExamStatus) gin.H {
+	return gin.H{
+		"exam_id":           s.ExamId,
+		"state":             s.State,
+		"total_students":    s.TotalStudents,
+		"students_started":  s.StudentsStarted,
+		"students_finished": s.StudentsFinished,
+	}
+}
+
+// examStatusDone melaporkan apakah status ujian tidak akan berubah lagi
+func examStatusDone(s *examv1.ExamStatus) bool {
+	if s.State != examv1.ExamState_EXAM_STATE_FINISHED {
+		return false
+	}
+	for _, student := range s.StudentStatuses {
+		if student.State == examv1.ExamStudentState_EXAM_STUDENT_STATE_IN_PROGRESS {
+			return false
+		}
+	}
+	return true
+}
+
+// diffExamStatus membandingkan dua status ujian dan menghasilkan event untuk
+// setiap siswa yang berubah
+func diffExamStatus(prev, next *examv1.ExamStatus) []examStatusEvent {
+	before := make(map[string]*examv1.StudentStatus, len(prev.StudentStatuses))
+	for _, s := range prev.StudentStatuses {
+		before[s.StudentId] = s
+	}
+
+	var events []examStatusEvent
+	for _, s := range next.StudentStatuses {
+		old, ok := before[s.StudentId]
+		if ok && old.State == s.State && old.Answered == s.Answered {
+			continue
+		}
+
+		name := "answered"
+		switch {
+		case !ok && s.State == examv1.ExamStudentState_EXAM_STUDENT_STATE_NOT_STARTED:
+			name = "joined"
+		case (!ok || old.State != s.State) && s.State == examv1.ExamStudentState_EXAM_STUDENT_STATE_IN_PROGRESS:
+			name = "started"
+		case (!ok || old.State != s.State) && s.State == examv1.ExamStudentState_EXAM_STUDENT_STATE_FINISHED:
+			name = "finished"
+		case (!ok || old.State != s.State) && s.State == examv1.ExamStudentState_EXAM_STUDENT_STATE_TIMED_OUT:
+			name = "timed_out"
+		}
+		events = append(events, examStatusEvent{name: name, data: s})
+	}
+
+	if prev.State != next.State ||
+		prev.TotalStudents != next.TotalStudents ||
+		prev.StudentsStarted != next.StudentsStarted ||
+		prev.StudentsFinished != next.StudentsFinished {
+		events = append(events, examStatusEvent{name: "summary", data: examStatusSummary(next)})
+	}
+
+	return events
+}
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	RoleStudent = "student"
+	RoleTeacher = "teacher"
+	RoleAdmin   = "admin"
+)
+
+// Kunci gin.Context untuk identitas pengguna yang sudah diverifikasi
+const (
+	userIDKey = "auth.user_id"
+	roleKey   = "auth.role"
+)
+
+// Claims adalah isi token akses. Subject berisi ID siswa, guru atau admin.
+type Claims struct {
+	Role string `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// Authenticate memverifikasi token Bearer (JWT HS256 bertanda tangan secret)
+// dan menyimpan ID serta peran pengguna di context. Peran hanya diambil dari
+// token yang valid, tidak pernah dari header lain yang dikirim klien.
+func Authenticate(secret []byte) gin.HandlerFunc {
+	parser := jwt.NewParser(
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+	)
+
+	return func(c *gin.Context) {
+		token, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if !ok || token == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "missing bearer token"})
+			return
+		}
+
+		var claims Claims
+		_, err := parser.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+			return secret, nil
+		})
+		if err != nil || claims.Subject == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid token"})
+			return
+		}
+
+		switch claims.Role {
+		case RoleStudent, RoleTeacher, RoleAdmin:
+		default:
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid token role"})
+			return
+		}
+
+		c.Set(userIDKey, claims.Subject)
+		c.Set(roleKey, claims.Role)
+		c.Next()
+	}
+}
+
+// CurrentUser mengembalikan ID dan peran pengguna yang sudah diautentikasi
+func CurrentUser(c *gin.Context) (userID, role string) {
+	return c.GetString(userIDKey), c.GetString(roleKey)
+}
+
+// isStaff melaporkan apakah pengguna boleh melihat data siswa lain
+func isStaff(c *gin.Context) bool {
+	_, role := CurrentUser(c)
+	return role == RoleTeacher || role == RoleAdmin
+}
+
+// RequireRole menolak request yang perannya tidak termasuk dalam roles.
+// Harus dipasang setelah Authenticate.
+func RequireRole(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		_, role := CurrentUser(c)
+		for _, r := range roles {
+			if role == r {
+				c.Next()
+				return
+			}
+		}
+
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "insufficient role"})
+	}
+}
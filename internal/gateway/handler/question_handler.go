@@ -86,11 +86,13 @@ func (h *QuestionHandler) ListQuestions(c *gin.Context) {
 		}
 	}
 	pageToken := c.Query("pageToken")
+	includeTotal, _ := strconv.ParseBool(c.Query("includeTotal"))
 
 	req := &questionv1.ListQuestionsRequest{
-		ExamId:    examID,
-		PageSize:  int32(pageSize),
-		PageToken: pageToken,
+		ExamId:       examID,
+		PageSize:     int32(pageSize),
+		PageToken:    pageToken,
+		IncludeTotal: includeTotal,
 	}
 
 	resp, err := h.client.ListQuestions(c.Request.Context(), req)
@@ -100,6 +102,12 @@ func (h *QuestionHandler) ListQuestions(c *gin.Context) {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
+
+		if st.Code() == codes.InvalidArgument {
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+			return
+		}
+
 		c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
 		return
 	}
@@ -145,6 +153,30 @@ func (h *QuestionHandler) GetExamQuestions(c *gin.Context) {
 	c.JSON(http.StatusOK, resp)
 }
 
+func (h *QuestionHandler) GetSessionQuestions(c *gin.Context) {
+	sessionID := c.Param("id")
+	resp, err := h.client.GetSessionQuestions(c.Request.Context(), &questionv1.GetSessionQuestionsRequest{
+		SessionId: sessionID,
+	})
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		if st.Code() == codes.NotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
 func (h *QuestionHandler) UpdateQuestion(c *gin.Context) {
 	id := c.Param("id")
 	var question questionv1.Question
@@ -1,10 +1,16 @@
 package handler
 
 import (
+	"archive/zip"
+	"encoding/csv"
+	"fmt"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/xuri/excelize/v2"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -42,6 +48,8 @@ func (h *ScoringHandler) CalculateScore(c *gin.Context) {
 			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
 		case codes.AlreadyExists:
 			c.JSON(http.StatusConflict, gin.H{"error": st.Message()})
+		case codes.FailedPrecondition:
+			c.JSON(http.StatusPreconditionFailed, gin.H{"error": st.Message()})
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
 		}
@@ -72,6 +80,12 @@ func (h *ScoringHandler) GetScore(c *gin.Context) {
 		return
 	}
 
+	// Siswa hanya boleh melihat nilainya sendiri
+	if userID, _ := CurrentUser(c); !isStaff(c) && score.StudentId != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "score belongs to another student"})
+		return
+	}
+
 	c.JSON(http.StatusOK, score)
 }
 
@@ -84,11 +98,22 @@ func (h *ScoringHandler) ListScores(c *gin.Context) {
 		}
 	}
 	pageToken := c.Query("pageToken")
+	includeTotal, _ := strconv.ParseBool(c.Query("includeTotal"))
 
 	req := &scoringv1.ListScoresRequest{
-		ExamId:    examID,
-		PageSize:  int32(pageSize),
-		PageToken: pageToken,
+		ExamId:       examID,
+		PageSize:     int32(pageSize),
+		PageToken:    pageToken,
+		IncludeTotal: includeTotal,
+	}
+	// Filter lulus/tidak lulus untuk perencanaan remedial
+	if passed := c.Query("passed"); passed != "" {
+		p, err := strconv.ParseBool(passed)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "passed must be true or false"})
+			return
+		}
+		req.Passed = &p
 	}
 
 	resp, err := h.client.ListScores(c.Request.Context(), req)
@@ -99,8 +124,349 @@ func (h *ScoringHandler) ListScores(c *gin.Context) {
 			return
 		}
 
-		if st.Code() == codes.NotFound {
+		switch st.Code() {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+var exportHeader = []interface{}{
+	"student_id", "student_name", "class_id", "correct_answers", "wrong_answers",
+	"unanswered", "score", "grade", "passed", "status",
+}
+
+func exportRow(row *scoringv1.ScoreExportRow) []interface{} {
+	score := row.Score
+	passed := ""
+	if score.Passed != nil {
+		passed = strconv.FormatBool(*score.Passed)
+	}
+	return []interface{}{
+		score.StudentId, row.StudentName, row.ClassId, score.CorrectAnswers, score.WrongAnswers,
+		score.Unanswered, score.Score, score.Grade, passed, scoreStatusName(score.Status),
+	}
+}
+
+// scoreStatusName mengembalikan status nilai seperti yang disimpan di domain
+// scoring, tanpa prefiks enum proto
+func scoreStatusName(s scoringv1.ScoreStatus) string {
+	switch s {
+	case scoringv1.ScoreStatus_SCORE_STATUS_PROVISIONAL:
+		return "PROVISIONAL"
+	case scoringv1.ScoreStatus_SCORE_STATUS_FINAL:
+		return "FINAL"
+	default:
+		return ""
+	}
+}
+
+func (h *ScoringHandler) ExportScores(c *gin.Context) {
+	examID := c.Param("examId")
+	format := c.DefaultQuery("format", "csv")
+	if format != "csv" && format != "xlsx" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "format must be csv or xlsx"})
+		return
+	}
+
+	stream, err := h.client.ExportScores(c.Request.Context(), &scoringv1.ExportScoresRequest{
+		ExamId: examID,
+	})
+	// Error dari server baru terlihat saat pesan pertama dibaca
+	var first *scoringv1.ScoreExportRow
+	if err == nil {
+		first, err = stream.Recv()
+		if err == io.EOF {
+			err = nil
+		}
+	}
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		switch st.Code() {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+		case codes.NotFound:
 			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+		}
+		return
+	}
+
+	// next mengembalikan baris berikutnya, atau nil jika stream sudah habis
+	next := func() (*scoringv1.ScoreExportRow, error) {
+		if first != nil {
+			row := first
+			first = nil
+			return row, nil
+		}
+		row, err := stream.Recv()
+		if err == io.EOF {
+			return nil, nil
+		}
+		return row, err
+	}
+
+	filename := fmt.Sprintf("scores-%s.%s", examID, format)
+	if format == "csv" {
+		writeScoresCSV(c, filename, next)
+		return
+	}
+	writeScoresXLSX(c, filename, next)
+}
+
+// writeScoresCSV menulis dan mengirim setiap baris begitu diterima. Header
+// sudah terkirim, jadi error di tengah stream hanya bisa memutus respons.
+func writeScoresCSV(c *gin.Context, filename string, next func() (*scoringv1.ScoreExportRow, error)) {
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Header("Content-Type", "text/csv")
+	c.Status(http.StatusOK)
+
+	w := csv.NewWriter(c.Writer)
+	if err := w.Write(csvRecord(exportHeader)); err != nil {
+		c.Error(err)
+		c.Abort()
+		return
+	}
+	for {
+		row, err := next()
+		if err != nil {
+			c.Error(err)
+			c.Abort()
+			break
+		}
+		if row == nil {
+			break
+		}
+		if err := w.Write(csvRecord(exportRow(row))); err != nil {
+			c.Error(err)
+			c.Abort()
+			return
+		}
+		w.Flush()
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		c.Error(err)
+		c.Abort()
+	}
+}
+
+func csvRecord(values []interface{}) []string {
+	record := make([]string, len(values))
+	for i, v := range values {
+		record[i] = fmt.Sprint(v)
+	}
+	return record
+}
+
+// writeScoresXLSX memakai stream writer excelize agar baris tidak ditahan
+// sebagai sel di memori. Format XLSX baru bisa dikirim setelah semua baris
+// ditulis: stream writer menahan paling banyak excelize.StreamChunkSize (16 MiB)
+// data sheet di memori lalu memindahkannya ke file sementara, dan arsip zip
+// akhirnya ditulis langsung ke c.Writer tanpa buffer tambahan.
+func writeScoresXLSX(c *gin.Context, filename string, next func() (*scoringv1.ScoreExportRow, error)) {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	sheet := f.GetSheetName(0)
+	sw, err := f.NewStreamWriter(sheet)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := sw.SetRow("A1", exportHeader); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	for i := 2; ; i++ {
+		row, err := next()
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if row == nil {
+			break
+		}
+		cell, _ := excelize.CoordinatesToCellName(1, i)
+		if err := sw.SetRow(cell, exportRow(row)); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	if err := sw.Flush(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Header("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	c.Status(http.StatusOK)
+	if err := f.Write(c.Writer); err != nil {
+		c.Error(err)
+	}
+}
+
+func (h *ScoringHandler) GetSessionReport(c *gin.Context) {
+	includeQuestions, _ := strconv.ParseBool(c.Query("questions"))
+	file, err := h.client.GetSessionReport(c.Request.Context(), &scoringv1.GetSessionReportRequest{
+		SessionId:        c.Param("sessionId"),
+		IncludeQuestions: includeQuestions,
+	})
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		switch st.Code() {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+		case codes.FailedPrecondition:
+			c.JSON(http.StatusPreconditionFailed, gin.H{"error": st.Message()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+		}
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, file.Filename))
+	c.Data(http.StatusOK, "application/pdf", file.Content)
+}
+
+// ExportExamReports mengemas rapor setiap sesi ke dalam zip, ditulis langsung
+// ke respons begitu setiap rapor diterima
+func (h *ScoringHandler) ExportExamReports(c *gin.Context) {
+	examID := c.Param("examId")
+	includeQuestions, _ := strconv.ParseBool(c.Query("questions"))
+
+	stream, err := h.client.ExportExamReports(c.Request.Context(), &scoringv1.ExportExamReportsRequest{
+		ExamId:           examID,
+		IncludeQuestions: includeQuestions,
+	})
+	// Error dari server baru terlihat saat pesan pertama dibaca
+	var file *scoringv1.ReportFile
+	if err == nil {
+		file, err = stream.Recv()
+		if err == io.EOF {
+			err = nil
+		}
+	}
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		switch st.Code() {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+		}
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="reports-%s.zip"`, examID))
+	c.Header("Content-Type", "application/zip")
+	c.Status(http.StatusOK)
+
+	zw := zip.NewWriter(c.Writer)
+	for file != nil {
+		w, err := zw.Create(file.Filename)
+		if err == nil {
+			_, err = w.Write(file.Content)
+		}
+		if err != nil {
+			c.Error(err)
+			c.Abort()
+			return
+		}
+
+		file, err = stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// Header sudah terkirim; zip dibiarkan tidak lengkap agar klien gagal membukanya
+			c.Error(err)
+			c.Abort()
+			return
+		}
+	}
+	if err := zw.Close(); err != nil {
+		c.Error(err)
+	}
+}
+
+func (h *ScoringHandler) AnalyzeCollusion(c *gin.Context) {
+	resp, err := h.client.AnalyzeCollusion(c.Request.Context(), &scoringv1.AnalyzeCollusionRequest{
+		ExamId: c.Param("examId"),
+	})
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		switch st.Code() {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *ScoringHandler) ListCollusionFlags(c *gin.Context) {
+	req := &scoringv1.ListCollusionFlagsRequest{
+		ExamId: c.Param("examId"),
+	}
+	// Filter status tinjauan, misal ?status=PENDING
+	if s := c.Query("status"); s != "" {
+		value, ok := scoringv1.CollusionStatus_value["COLLUSION_STATUS_"+strings.ToUpper(s)]
+		if !ok {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "status must be PENDING, CONFIRMED or DISMISSED"})
+			return
+		}
+		req.Status = scoringv1.CollusionStatus(value)
+	}
+
+	resp, err := h.client.ListCollusionFlags(c.Request.Context(), req)
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		if st.Code() == codes.InvalidArgument {
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
 			return
 		}
 
@@ -110,3 +476,310 @@ func (h *ScoringHandler) ListScores(c *gin.Context) {
 
 	c.JSON(http.StatusOK, resp)
 }
+
+func (h *ScoringHandler) ReviewCollusionFlag(c *gin.Context) {
+	var req scoringv1.ReviewCollusionFlagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	req.Id = c.Param("id")
+
+	flag, err := h.client.ReviewCollusionFlag(c.Request.Context(), &req)
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		switch st.Code() {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, flag)
+}
+
+func (h *ScoringHandler) ListPendingGrading(c *gin.Context) {
+	examID := c.Param("examId")
+	pageSize := 10 // default page size
+	if size := c.Query("pageSize"); size != "" {
+		if s, err := strconv.Atoi(size); err == nil {
+			pageSize = s
+		}
+	}
+
+	includeTotal, _ := strconv.ParseBool(c.Query("includeTotal"))
+
+	resp, err := h.client.ListPendingGrading(c.Request.Context(), &scoringv1.ListPendingGradingRequest{
+		ExamId:       examID,
+		PageSize:     int32(pageSize),
+		PageToken:    c.Query("pageToken"),
+		IncludeTotal: includeTotal,
+	})
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		if st.Code() == codes.InvalidArgument {
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *ScoringHandler) GradeAnswer(c *gin.Context) {
+	var req scoringv1.GradeAnswerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.GradeAnswer(c.Request.Context(), &req)
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		switch st.Code() {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+		case codes.FailedPrecondition:
+			c.JSON(http.StatusPreconditionFailed, gin.H{"error": st.Message()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *ScoringHandler) RegradeExam(c *gin.Context) {
+	var req scoringv1.RegradeExamRequest
+	// Body opsional, hanya berisi question_id
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	req.ExamId = c.Param("examId")
+
+	resp, err := h.client.RegradeExam(c.Request.Context(), &req)
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		switch st.Code() {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+			return
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *ScoringHandler) SetGradingScale(c *gin.Context) {
+	var scale scoringv1.GradingScale
+	if err := c.ShouldBindJSON(&scale); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.SetGradingScale(c.Request.Context(), &scoringv1.SetGradingScaleRequest{
+		Scale: &scale,
+	})
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		switch st.Code() {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *ScoringHandler) GetGradingScale(c *gin.Context) {
+	resp, err := h.client.GetGradingScale(c.Request.Context(), &scoringv1.GetGradingScaleRequest{
+		ExamId:  c.Query("examId"),
+		Subject: c.Query("subject"),
+	})
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		switch st.Code() {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *ScoringHandler) GetExamStatistics(c *gin.Context) {
+	req := &scoringv1.GetExamStatisticsRequest{
+		ExamId: c.Param("examId"),
+	}
+	if buckets := c.Query("buckets"); buckets != "" {
+		b, err := strconv.Atoi(buckets)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "buckets must be a number"})
+			return
+		}
+		req.Buckets = int32(b)
+	}
+
+	resp, err := h.client.GetExamStatistics(c.Request.Context(), req)
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		switch st.Code() {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *ScoringHandler) GetItemAnalysis(c *gin.Context) {
+	resp, err := h.client.GetItemAnalysis(c.Request.Context(), &scoringv1.GetItemAnalysisRequest{
+		ExamId: c.Param("examId"),
+	})
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		switch st.Code() {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+		}
+		return
+	}
+
+	if c.Query("format") != "csv" {
+		c.JSON(http.StatusOK, resp)
+		return
+	}
+
+	// Kolom pilihan mengikuti soal dengan pilihan terbanyak
+	var letters []string
+	for _, item := range resp.Items {
+		for i := len(letters); i < len(item.Choices); i++ {
+			letters = append(letters, item.Choices[i].Choice)
+		}
+	}
+
+	header := []string{"question_id", "question_text", "question_type", "correct_answer",
+		"responses", "omitted", "difficulty", "discrimination"}
+	for _, letter := range letters {
+		header = append(header, "choice_"+letter)
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="item-analysis-%s.csv"`, resp.ExamId))
+	c.Header("Content-Type", "text/csv")
+	c.Status(http.StatusOK)
+
+	// Header sudah terkirim, jadi error penulisan hanya bisa memutus respons
+	w := csv.NewWriter(c.Writer)
+	if err := w.Write(header); err != nil {
+		c.Error(err)
+		c.Abort()
+		return
+	}
+	for _, item := range resp.Items {
+		row := []string{
+			item.QuestionId,
+			item.QuestionText,
+			item.QuestionType,
+			item.CorrectAnswer,
+			strconv.Itoa(int(item.Responses)),
+			strconv.Itoa(int(item.Omitted)),
+			strconv.FormatFloat(item.Difficulty, 'f', 3, 64),
+			strconv.FormatFloat(item.Discrimination, 'f', 3, 64),
+		}
+		for i := range letters {
+			count := ""
+			if i < len(item.Choices) {
+				count = strconv.Itoa(int(item.Choices[i].Count))
+			}
+			row = append(row, count)
+		}
+		if err := w.Write(row); err != nil {
+			c.Error(err)
+			c.Abort()
+			return
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		c.Error(err)
+		c.Abort()
+	}
+}
@@ -21,6 +21,41 @@ func NewSessionHandler(client *client.ServiceClient) *SessionHandler {
 	}
 }
 
+// RequireSessionOwner menolak siswa yang mengakses sesi milik siswa lain.
+// Guru dan admin boleh mengakses semua sesi.
+func (h *SessionHandler) RequireSessionOwner(c *gin.Context) {
+	if isStaff(c) {
+		c.Next()
+		return
+	}
+
+	session, err := h.client.GetSession(c.Request.Context(), &sessionv1.GetSessionRequest{
+		Id: c.Param("id"),
+	})
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		if st.Code() == codes.NotFound {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": st.Message()})
+			return
+		}
+
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+		return
+	}
+
+	if userID, _ := CurrentUser(c); session.StudentId != userID {
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "session belongs to another student"})
+		return
+	}
+
+	c.Next()
+}
+
 func (h *SessionHandler) StartSession(c *gin.Context) {
 	var req sessionv1.StartSessionRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -28,6 +63,16 @@ func (h *SessionHandler) StartSession(c *gin.Context) {
 		return
 	}
 
+	// Siswa hanya boleh memulai sesi untuk dirinya sendiri
+	if !isStaff(c) {
+		userID, _ := CurrentUser(c)
+		if req.StudentId != "" && req.StudentId != userID {
+			c.JSON(http.StatusForbidden, gin.H{"error": "cannot start a session for another student"})
+			return
+		}
+		req.StudentId = userID
+	}
+
 	session, err := h.client.StartSession(c.Request.Context(), &req)
 	if err != nil {
 		st, ok := status.FromError(err)
@@ -41,6 +86,8 @@ func (h *SessionHandler) StartSession(c *gin.Context) {
 			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
 		case codes.FailedPrecondition:
 			c.JSON(http.StatusPreconditionFailed, gin.H{"error": st.Message()})
+		case codes.PermissionDenied:
+			c.JSON(http.StatusForbidden, gin.H{"error": st.Message()})
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
 		}
@@ -94,6 +141,8 @@ func (h *SessionHandler) SubmitAnswer(c *gin.Context) {
 		switch st.Code() {
 		case codes.NotFound:
 			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
 		case codes.FailedPrecondition:
 			c.JSON(http.StatusPreconditionFailed, gin.H{"error": st.Message()})
 		default:
@@ -154,3 +203,36 @@ func (h *SessionHandler) GetRemainingTime(c *gin.Context) {
 
 	c.JSON(http.StatusOK, time)
 }
+
+func (h *SessionHandler) GrantExtraTime(c *gin.Context) {
+	id := c.Param("id")
+	var req sessionv1.GrantExtraTimeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	req.SessionId = id
+
+	session, err := h.client.GrantExtraTime(c.Request.Context(), &req)
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		switch st.Code() {
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+		case codes.FailedPrecondition:
+			c.JSON(http.StatusPreconditionFailed, gin.H{"error": st.Message()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, session)
+}
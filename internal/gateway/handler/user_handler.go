@@ -0,0 +1,359 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userv1 "github.com/ApesJs/cbt-exam/api/proto/user/v1"
+	"github.com/ApesJs/cbt-exam/pkg/client"
+)
+
+// maxImportSize membatasi ukuran file CSV impor siswa
+const maxImportSize = 5 << 20
+
+type UserHandler struct {
+	client *client.ServiceClient
+}
+
+func NewUserHandler(client *client.ServiceClient) *UserHandler {
+	return &UserHandler{
+		client: client,
+	}
+}
+
+// writeUserError memetakan error UserService ke status HTTP
+func writeUserError(c *gin.Context, err error) {
+	st, ok := status.FromError(err)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+	case codes.AlreadyExists:
+		c.JSON(http.StatusConflict, gin.H{"error": st.Message()})
+	case codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
+	}
+}
+
+// pageQuery membaca parameter paginasi yang sama untuk semua daftar
+func pageQuery(c *gin.Context) (pageSize int32, pageToken string, includeTotal bool) {
+	if size, err := strconv.Atoi(c.Query("pageSize")); err == nil {
+		pageSize = int32(size)
+	}
+	includeTotal, _ = strconv.ParseBool(c.Query("includeTotal"))
+	return pageSize, c.Query("pageToken"), includeTotal
+}
+
+func (h *UserHandler) CreateStudent(c *gin.Context) {
+	var req userv1.CreateStudentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	student, err := h.client.CreateStudent(c.Request.Context(), &req)
+	if err != nil {
+		writeUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, student)
+}
+
+func (h *UserHandler) GetStudent(c *gin.Context) {
+	student, err := h.client.GetStudent(c.Request.Context(), &userv1.GetStudentRequest{
+		Id: c.Param("id"),
+	})
+	if err != nil {
+		writeUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, student)
+}
+
+// ListStudents menampilkan semua siswa, atau siswa satu kelas jika dipanggil
+// melalui /classes/:id/students
+func (h *UserHandler) ListStudents(c *gin.Context) {
+	pageSize, pageToken, includeTotal := pageQuery(c)
+	req := &userv1.ListStudentsRequest{
+		ClassId:      c.Query("classId"),
+		PageSize:     pageSize,
+		PageToken:    pageToken,
+		IncludeTotal: includeTotal,
+		Query:        c.Query("q"),
+	}
+	if id := c.Param("id"); id != "" {
+		req.ClassId = id
+	}
+
+	resp, err := h.client.ListStudents(c.Request.Context(), req)
+	if err != nil {
+		writeUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *UserHandler) UpdateStudent(c *gin.Context) {
+	var student userv1.Student
+	if err := c.ShouldBindJSON(&student); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.UpdateStudent(c.Request.Context(), &userv1.UpdateStudentRequest{
+		Id:      c.Param("id"),
+		Student: &student,
+	})
+	if err != nil {
+		writeUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *UserHandler) DeleteStudent(c *gin.Context) {
+	err := h.client.DeleteStudent(c.Request.Context(), &userv1.DeleteStudentRequest{
+		Id: c.Param("id"),
+	})
+	if err != nil {
+		writeUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// ImportStudents menerima file CSV sebagai multipart (field "file") atau
+// langsung sebagai body dengan Content-Type text/csv
+func (h *UserHandler) ImportStudents(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportSize)
+
+	var content []byte
+	if c.ContentType() == "multipart/form-data" {
+		file, err := c.FormFile("file")
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		f, err := file.Open()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		defer f.Close()
+		content, err = io.ReadAll(f)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	} else {
+		var err error
+		content, err = c.GetRawData()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	resp, err := h.client.ImportStudents(c.Request.Context(), &userv1.ImportStudentsRequest{
+		Content: content,
+	})
+	if err != nil {
+		writeUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *UserHandler) CreateTeacher(c *gin.Context) {
+	var req userv1.CreateTeacherRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	teacher, err := h.client.CreateTeacher(c.Request.Context(), &req)
+	if err != nil {
+		writeUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, teacher)
+}
+
+func (h *UserHandler) GetTeacher(c *gin.Context) {
+	teacher, err := h.client.GetTeacher(c.Request.Context(), &userv1.GetTeacherRequest{
+		Id: c.Param("id"),
+	})
+	if err != nil {
+		writeUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, teacher)
+}
+
+func (h *UserHandler) ListTeachers(c *gin.Context) {
+	pageSize, pageToken, includeTotal := pageQuery(c)
+	resp, err := h.client.ListTeachers(c.Request.Context(), &userv1.ListTeachersRequest{
+		PageSize:     pageSize,
+		PageToken:    pageToken,
+		IncludeTotal: includeTotal,
+	})
+	if err != nil {
+		writeUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *UserHandler) UpdateTeacher(c *gin.Context) {
+	var teacher userv1.Teacher
+	if err := c.ShouldBindJSON(&teacher); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.UpdateTeacher(c.Request.Context(), &userv1.UpdateTeacherRequest{
+		Id:      c.Param("id"),
+		Teacher: &teacher,
+	})
+	if err != nil {
+		writeUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *UserHandler) DeleteTeacher(c *gin.Context) {
+	err := h.client.DeleteTeacher(c.Request.Context(), &userv1.DeleteTeacherRequest{
+		Id: c.Param("id"),
+	})
+	if err != nil {
+		writeUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *UserHandler) CreateClass(c *gin.Context) {
+	var req userv1.CreateClassRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	class, err := h.client.CreateClass(c.Request.Context(), &req)
+	if err != nil {
+		writeUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, class)
+}
+
+func (h *UserHandler) GetClass(c *gin.Context) {
+	class, err := h.client.GetClass(c.Request.Context(), &userv1.GetClassRequest{
+		Id: c.Param("id"),
+	})
+	if err != nil {
+		writeUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, class)
+}
+
+func (h *UserHandler) ListClasses(c *gin.Context) {
+	pageSize, pageToken, includeTotal := pageQuery(c)
+	resp, err := h.client.ListClasses(c.Request.Context(), &userv1.ListClassesRequest{
+		PageSize:     pageSize,
+		PageToken:    pageToken,
+		IncludeTotal: includeTotal,
+	})
+	if err != nil {
+		writeUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *UserHandler) UpdateClass(c *gin.Context) {
+	var class userv1.Class
+	if err := c.ShouldBindJSON(&class); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.UpdateClass(c.Request.Context(), &userv1.UpdateClassRequest{
+		Id:    c.Param("id"),
+		Class: &class,
+	})
+	if err != nil {
+		writeUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *UserHandler) DeleteClass(c *gin.Context) {
+	err := h.client.DeleteClass(c.Request.Context(), &userv1.DeleteClassRequest{
+		Id: c.Param("id"),
+	})
+	if err != nil {
+		writeUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *UserHandler) EnrollStudents(c *gin.Context) {
+	var req userv1.EnrollStudentsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	req.ClassId = c.Param("id")
+
+	if err := h.client.EnrollStudents(c.Request.Context(), &req); err != nil {
+		writeUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *UserHandler) UnenrollStudent(c *gin.Context) {
+	err := h.client.UnenrollStudent(c.Request.Context(), &userv1.UnenrollStudentRequest{
+		ClassId:   c.Param("id"),
+		StudentId: c.Param("studentId"),
+	})
+	if err != nil {
+		writeUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
@@ -29,11 +29,18 @@ func main() {
 		cfg.QuestionPort,
 		cfg.SessionPort,
 		cfg.ScoringPort,
+		cfg.UserPort,
 	)
 	if err != nil {
 		log.Fatalf("Failed to create service client: %v", err)
 	}
 
+	// Token akses wajib ditandatangani; tanpa secret gateway tidak dapat
+	// memverifikasi peran pengguna
+	if cfg.AuthSecret == "" {
+		log.Fatal("AUTH_SECRET is required")
+	}
+
 	// Initialize Gin router
 	router := gin.Default()
 
@@ -42,24 +49,32 @@ func main() {
 	questionHandler := handler.NewQuestionHandler(serviceClient)
 	sessionHandler := handler.NewSessionHandler(serviceClient)
 	scoringHandler := handler.NewScoringHandler(serviceClient)
+	userHandler := handler.NewUserHandler(serviceClient)
 
 	// Setup routes
-	v1 := router.Group("/api/v1")
+	// Semua route membutuhkan token akses yang valid; peran dan ID pengguna
+	// hanya diambil dari token tersebut
+	v1 := router.Group("/api/v1", handler.Authenticate([]byte(cfg.AuthSecret)))
 	{
-		// Exam routes
+		// Exam routes; siswa hanya boleh membaca
 		exam := v1.Group("/exams")
 		{
-			exam.POST("", examHandler.CreateExam)
+			proctorOnly := handler.RequireRole(handler.RoleTeacher, handler.RoleAdmin)
+			exam.POST("", proctorOnly, examHandler.CreateExam)
 			exam.GET("", examHandler.ListExams)
 			exam.GET("/:id", examHandler.GetExam)
-			exam.PUT("/:id", examHandler.UpdateExam)
-			exam.DELETE("/:id", examHandler.DeleteExam)
-			exam.POST("/:id/activate", examHandler.ActivateExam)
-			exam.POST("/:id/deactivate", examHandler.DeactivateExam)
+			exam.PUT("/:id", proctorOnly, examHandler.UpdateExam)
+			exam.DELETE("/:id", proctorOnly, examHandler.DeleteExam)
+			exam.POST("/:id/activate", proctorOnly, examHandler.ActivateExam)
+			exam.POST("/:id/deactivate", proctorOnly, examHandler.DeactivateExam)
+
+			// Pemantauan peserta: status sekali baca atau stream SSE
+			exam.GET("/:id/status", proctorOnly, examHandler.GetExamStatus)
+			exam.GET("/:id/status/stream", proctorOnly, examHandler.WatchExamStatus)
 		}
 
-		// Question routes
-		question := v1.Group("/questions")
+		// Soal memuat kunci jawaban, hanya untuk guru; siswa mengambil soal lewat /sessions/:id/questions
+		question := v1.Group("/questions", handler.RequireRole(handler.RoleTeacher, handler.RoleAdmin))
 		{
 			question.POST("", questionHandler.CreateQuestion)
 			question.GET("", questionHandler.ListQuestions)
@@ -69,22 +84,90 @@ func main() {
 			question.GET("/exam/:examId", questionHandler.GetExamQuestions)
 		}
 
-		// Session routes
+		// Session routes; siswa hanya boleh mengakses sesinya sendiri
 		session := v1.Group("/sessions")
 		{
+			ownerOnly := sessionHandler.RequireSessionOwner
 			session.POST("", sessionHandler.StartSession)
-			session.GET("/:id", sessionHandler.GetSession)
-			session.POST("/:id/answer", sessionHandler.SubmitAnswer)
-			session.POST("/:id/finish", sessionHandler.FinishSession)
-			session.GET("/:id/time", sessionHandler.GetRemainingTime)
+			session.GET("/:id", ownerOnly, sessionHandler.GetSession)
+			session.GET("/:id/questions", ownerOnly, questionHandler.GetSessionQuestions)
+			session.POST("/:id/answer", ownerOnly, sessionHandler.SubmitAnswer)
+			session.POST("/:id/finish", ownerOnly, sessionHandler.FinishSession)
+			session.GET("/:id/time", ownerOnly, sessionHandler.GetRemainingTime)
+			session.POST("/:id/extra-time", handler.RequireRole(handler.RoleTeacher, handler.RoleAdmin), sessionHandler.GrantExtraTime)
 		}
 
-		// Scoring routes
+		// Scoring routes; siswa hanya boleh melihat nilainya sendiri
 		score := v1.Group("/scores")
 		{
-			score.POST("/calculate", scoringHandler.CalculateScore)
+			teacherOnly := handler.RequireRole(handler.RoleTeacher, handler.RoleAdmin)
+			score.POST("/calculate", teacherOnly, scoringHandler.CalculateScore)
 			score.GET("/:id", scoringHandler.GetScore)
-			score.GET("/exam/:examId", scoringHandler.ListScores)
+			score.GET("/exam/:examId", teacherOnly, scoringHandler.ListScores)
+
+			// Penilaian manual soal teks bebas
+			score.GET("/exam/:examId/pending", teacherOnly, scoringHandler.ListPendingGrading)
+			score.POST("/grade", teacherOnly, scoringHandler.GradeAnswer)
+			score.POST("/exam/:examId/regrade", teacherOnly, scoringHandler.RegradeExam)
+
+			// Analisis butir soal, JSON atau CSV (?format=csv)
+			score.GET("/exam/:examId/items", teacherOnly, scoringHandler.GetItemAnalysis)
+			score.GET("/exam/:examId/statistics", teacherOnly, scoringHandler.GetExamStatistics)
+
+			// Ekspor buku nilai, CSV (default) atau XLSX (?format=xlsx)
+			score.GET("/exam/:examId/export", teacherOnly, scoringHandler.ExportScores)
+
+			// Rapor PDF per sesi, atau semua rapor ujian dalam satu zip
+			score.GET("/session/:sessionId/report", teacherOnly, scoringHandler.GetSessionReport)
+			score.GET("/exam/:examId/reports", teacherOnly, scoringHandler.ExportExamReports)
+
+			// Deteksi kecurangan dari kemiripan jawaban salah
+			score.POST("/exam/:examId/collusion", teacherOnly, scoringHandler.AnalyzeCollusion)
+			score.GET("/exam/:examId/collusion", teacherOnly, scoringHandler.ListCollusionFlags)
+			score.PUT("/collusion/:id", teacherOnly, scoringHandler.ReviewCollusionFlag)
+
+			// Skala penilaian (KKM dan predikat)
+			score.GET("/scales", scoringHandler.GetGradingScale)
+			score.PUT("/scales", teacherOnly, scoringHandler.SetGradingScale)
+		}
+
+		// Data siswa, guru dan kelas; hanya admin yang boleh mengubah
+		staffOnly := handler.RequireRole(handler.RoleTeacher, handler.RoleAdmin)
+		adminOnly := handler.RequireRole(handler.RoleAdmin)
+
+		student := v1.Group("/students", staffOnly)
+		{
+			student.POST("", adminOnly, userHandler.CreateStudent)
+			student.GET("", userHandler.ListStudents)
+			student.GET("/:id", userHandler.GetStudent)
+			student.PUT("/:id", adminOnly, userHandler.UpdateStudent)
+			student.DELETE("/:id", adminOnly, userHandler.DeleteStudent)
+
+			// Impor CSV: student_number (nis), name, email, class
+			student.POST("/import", adminOnly, userHandler.ImportStudents)
+		}
+
+		teacher := v1.Group("/teachers", staffOnly)
+		{
+			teacher.POST("", adminOnly, userHandler.CreateTeacher)
+			teacher.GET("", userHandler.ListTeachers)
+			teacher.GET("/:id", userHandler.GetTeacher)
+			teacher.PUT("/:id", adminOnly, userHandler.UpdateTeacher)
+			teacher.DELETE("/:id", adminOnly, userHandler.DeleteTeacher)
+		}
+
+		class := v1.Group("/classes", staffOnly)
+		{
+			class.POST("", adminOnly, userHandler.CreateClass)
+			class.GET("", userHandler.ListClasses)
+			class.GET("/:id", userHandler.GetClass)
+			class.PUT("/:id", adminOnly, userHandler.UpdateClass)
+			class.DELETE("/:id", adminOnly, userHandler.DeleteClass)
+
+			// Anggota kelas
+			class.GET("/:id/students", userHandler.ListStudents)
+			class.POST("/:id/students", adminOnly, userHandler.EnrollStudents)
+			class.DELETE("/:id/students/:studentId", adminOnly, userHandler.UnenrollStudent)
 		}
 	}
 
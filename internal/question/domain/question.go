@@ -1,17 +1,46 @@
 package domain
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"time"
+
+	"github.com/ApesJs/cbt-exam/pkg/choiceset"
+	"github.com/ApesJs/cbt-exam/pkg/shuffle"
+)
+
+type QuestionType string
+
+const (
+	QuestionTypeMultipleChoice QuestionType = "MULTIPLE_CHOICE"
+	// QuestionTypeMultipleAnswer adalah soal dengan lebih dari satu pilihan benar
+	QuestionTypeMultipleAnswer QuestionType = "MULTIPLE_ANSWER"
+	// QuestionTypeEssay dan QuestionTypeShortAnswer dijawab dengan teks bebas dan dinilai manual
+	QuestionTypeEssay       QuestionType = "ESSAY"
+	QuestionTypeShortAnswer QuestionType = "SHORT_ANSWER"
 )
 
+// IsManuallyGraded melaporkan apakah jawaban soal harus dinilai oleh guru
+func (t QuestionType) IsManuallyGraded() bool {
+	return t == QuestionTypeEssay || t == QuestionTypeShortAnswer
+}
+
 type Question struct {
-	ID            string    `json:"id"`
-	ExamID        string    `json:"exam_id"`
-	QuestionText  string    `json:"question_text"`
-	Choices       []Choice  `json:"choices"`
-	CorrectAnswer string    `json:"correct_answer"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
+	ID           string       `json:"id"`
+	ExamID       string       `json:"exam_id"`
+	QuestionText string       `json:"question_text"`
+	Type         QuestionType `json:"type"`
+	Choices      []Choice     `json:"choices"`
+	// CorrectAnswer berisi huruf kanonik, dipisah koma untuk soal MULTIPLE_ANSWER.
+	// Untuk soal yang dinilai manual berisi contoh jawaban atau rubrik (opsional).
+	CorrectAnswer string `json:"correct_answer"`
+	// PartialCredit memberi nilai sebagian pada soal MULTIPLE_ANSWER
+	PartialCredit bool `json:"partial_credit"`
+	// Points adalah bobot soal, default 1
+	Points    float64   `json:"points"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 type Choice struct {
@@ -19,6 +48,70 @@ type Choice struct {
 	Text string `json:"text"`
 }
 
+// ShuffleChoices mengurutkan ulang pilihan sesuai seed sesi. Huruf kunci jawaban
+// tetap mengacu pada urutan kanonik, bukan urutan yang ditampilkan.
+func (q *Question) ShuffleChoices(seed int64) {
+	perm := shuffle.Permutation(seed, q.ID, len(q.Choices))
+	shuffled := make([]Choice, len(q.Choices))
+	for displayed, canonical := range perm {
+		shuffled[displayed] = q.Choices[canonical]
+	}
+	q.Choices = shuffled
+}
+
+// Normalize memvalidasi bobot dan kunci jawaban terhadap tipe soal dan jumlah
+// pilihan, lalu menyimpannya dalam bentuk kanonik
+func (q *Question) Normalize() error {
+	if q.Type == "" {
+		q.Type = QuestionTypeMultipleChoice
+	}
+
+	if q.Points < 0 {
+		return errors.New("points must not be negative")
+	}
+	if q.Points == 0 {
+		q.Points = 1
+	}
+
+	if q.Type.IsManuallyGraded() {
+		if len(q.Choices) > 0 {
+			return errors.New("free-text questions cannot have choices")
+		}
+		if q.PartialCredit {
+			return errors.New("partial credit is only available for multiple answer questions")
+		}
+		q.CorrectAnswer = strings.TrimSpace(q.CorrectAnswer)
+		return nil
+	}
+
+	letters := choiceset.Parse(q.CorrectAnswer)
+	if len(letters) == 0 {
+		return errors.New("correct answer is required")
+	}
+	for _, letter := range letters {
+		idx, ok := shuffle.Index(letter)
+		if !ok || idx >= len(q.Choices) {
+			return fmt.Errorf("correct answer %q does not match any choice", letter)
+		}
+	}
+
+	switch q.Type {
+	case QuestionTypeMultipleChoice:
+		if len(letters) != 1 {
+			return errors.New("multiple choice question must have exactly one correct answer")
+		}
+		if q.PartialCredit {
+			return errors.New("partial credit is only available for multiple answer questions")
+		}
+	case QuestionTypeMultipleAnswer:
+	default:
+		return fmt.Errorf("unsupported question type %q", q.Type)
+	}
+
+	q.CorrectAnswer = choiceset.Format(letters)
+	return nil
+}
+
 // Untuk mendapatkan soal ujian dengan jumlah dan urutan tertentu
 type QuestionFilter struct {
 	ExamID    string
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ApesJs/cbt-exam/internal/question/domain"
 	"github.com/ApesJs/cbt-exam/internal/question/repository"
+	"github.com/ApesJs/cbt-exam/pkg/pagination"
 )
 
 type postgresRepository struct {
@@ -28,8 +29,8 @@ func (r *postgresRepository) Create(ctx context.Context, question *domain.Questi
 
 	// Insert question
 	query := `
-        INSERT INTO questions (exam_id, question_text, correct_answer)
-        VALUES ($1, $2, $3)
+        INSERT INTO questions (exam_id, question_text, type, correct_answer, partial_credit, points)
+        VALUES ($1, $2, $3, $4, $5, $6)
         RETURNING id, created_at, updated_at`
 
 	err = tx.QueryRowContext(
@@ -37,7 +38,10 @@ func (r *postgresRepository) Create(ctx context.Context, question *domain.Questi
 		query,
 		question.ExamID,
 		question.QuestionText,
+		question.Type,
 		question.CorrectAnswer,
+		question.PartialCredit,
+		question.Points,
 	).Scan(&question.ID, &question.CreatedAt, &question.UpdatedAt)
 	if err != nil {
 		return errors.Wrap(err, "failed to insert question")
@@ -46,8 +50,8 @@ func (r *postgresRepository) Create(ctx context.Context, question *domain.Questi
 	// Insert choices
 	if len(question.Choices) > 0 {
 		choiceQuery := `
-            INSERT INTO choices (question_id, text)
-            VALUES ($1, $2)
+            INSERT INTO choices (question_id, text, position)
+            VALUES ($1, $2, $3)
             RETURNING id`
 
 		for i := range question.Choices {
@@ -56,6 +60,7 @@ func (r *postgresRepository) Create(ctx context.Context, question *domain.Questi
 				choiceQuery,
 				question.ID,
 				question.Choices[i].Text,
+				i,
 			).Scan(&question.Choices[i].ID)
 			if err != nil {
 				return errors.Wrap(err, "failed to insert choice")
@@ -68,7 +73,7 @@ func (r *postgresRepository) Create(ctx context.Context, question *domain.Questi
 
 func (r *postgresRepository) GetByID(ctx context.Context, id string) (*domain.Question, error) {
 	query := `
-        SELECT q.id, q.exam_id, q.question_text, q.correct_answer, 
+        SELECT q.id, q.exam_id, q.question_text, q.type, q.correct_answer, q.partial_credit, q.points,
                q.created_at, q.updated_at
         FROM questions q
         WHERE q.id = $1`
@@ -78,7 +83,10 @@ func (r *postgresRepository) GetByID(ctx context.Context, id string) (*domain.Qu
 		&question.ID,
 		&question.ExamID,
 		&question.QuestionText,
+		&question.Type,
 		&question.CorrectAnswer,
+		&question.PartialCredit,
+		&question.Points,
 		&question.CreatedAt,
 		&question.UpdatedAt,
 	)
@@ -94,7 +102,7 @@ func (r *postgresRepository) GetByID(ctx context.Context, id string) (*domain.Qu
         SELECT id, text
         FROM choices
         WHERE question_id = $1
-        ORDER BY id`
+        ORDER BY position, id`
 
 	rows, err := r.db.QueryContext(ctx, choiceQuery, id)
 	if err != nil {
@@ -114,16 +122,23 @@ func (r *postgresRepository) GetByID(ctx context.Context, id string) (*domain.Qu
 	return question, nil
 }
 
-func (r *postgresRepository) List(ctx context.Context, examID string, limit int32, offset int32) ([]*domain.Question, error) {
+// List mengembalikan soal sesuai urutan dibuat, mulai setelah cursor after
+func (r *postgresRepository) List(ctx context.Context, examID string, limit int32, after *pagination.Cursor) ([]*domain.Question, error) {
 	query := `
-        SELECT q.id, q.exam_id, q.question_text, q.correct_answer, 
+        SELECT q.id, q.exam_id, q.question_text, q.type, q.correct_answer, q.partial_credit, q.points,
                q.created_at, q.updated_at
         FROM questions q
         WHERE q.exam_id = $1
-        ORDER BY q.created_at
-        LIMIT $2 OFFSET $3`
+          AND ($2::timestamptz IS NULL OR (q.created_at, q.id) > ($2, $3::uuid))
+        ORDER BY q.created_at, q.id
+        LIMIT $4`
 
-	rows, err := r.db.QueryContext(ctx, query, examID, limit, offset)
+	var afterTime, afterID interface{}
+	if after != nil {
+		afterTime, afterID = after.Time, after.ID
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, examID, afterTime, afterID, limit)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to list questions")
 	}
@@ -136,7 +151,10 @@ func (r *postgresRepository) List(ctx context.Context, examID string, limit int3
 			&question.ID,
 			&question.ExamID,
 			&question.QuestionText,
+			&question.Type,
 			&question.CorrectAnswer,
+			&question.PartialCredit,
+			&question.Points,
 			&question.CreatedAt,
 			&question.UpdatedAt,
 		)
@@ -152,7 +170,7 @@ func (r *postgresRepository) List(ctx context.Context, examID string, limit int3
             SELECT id, text
             FROM choices
             WHERE question_id = $1
-            ORDER BY id`
+            ORDER BY position, id`
 
 		rows, err := r.db.QueryContext(ctx, choiceQuery, q.ID)
 		if err != nil {
@@ -183,15 +201,19 @@ func (r *postgresRepository) Update(ctx context.Context, question *domain.Questi
 	// Update question
 	query := `
         UPDATE questions 
-        SET question_text = $1, correct_answer = $2, updated_at = CURRENT_TIMESTAMP
-        WHERE id = $3
+        SET question_text = $1, type = $2, correct_answer = $3, partial_credit = $4,
+            points = $5, updated_at = CURRENT_TIMESTAMP
+        WHERE id = $6
         RETURNING updated_at`
 
 	err = tx.QueryRowContext(
 		ctx,
 		query,
 		question.QuestionText,
+		question.Type,
 		question.CorrectAnswer,
+		question.PartialCredit,
+		question.Points,
 		question.ID,
 	).Scan(&question.UpdatedAt)
 
@@ -211,8 +233,8 @@ func (r *postgresRepository) Update(ctx context.Context, question *domain.Questi
 	// Insert new choices
 	if len(question.Choices) > 0 {
 		choiceQuery := `
-            INSERT INTO choices (question_id, text)
-            VALUES ($1, $2)
+            INSERT INTO choices (question_id, text, position)
+            VALUES ($1, $2, $3)
             RETURNING id`
 
 		for i := range question.Choices {
@@ -221,6 +243,7 @@ func (r *postgresRepository) Update(ctx context.Context, question *domain.Questi
 				choiceQuery,
 				question.ID,
 				question.Choices[i].Text,
+				i,
 			).Scan(&question.Choices[i].ID)
 			if err != nil {
 				return errors.Wrap(err, "failed to insert choice")
@@ -253,7 +276,7 @@ func (r *postgresRepository) GetExamQuestions(ctx context.Context, filter domain
 	var query string
 	if filter.Randomize {
 		query = `
-            SELECT q.id, q.exam_id, q.question_text, q.correct_answer, 
+            SELECT q.id, q.exam_id, q.question_text, q.type, q.correct_answer, q.partial_credit, q.points,
                    q.created_at, q.updated_at
             FROM questions q
             WHERE q.exam_id = $1
@@ -261,7 +284,7 @@ func (r *postgresRepository) GetExamQuestions(ctx context.Context, filter domain
             LIMIT $2`
 	} else {
 		query = `
-            SELECT q.id, q.exam_id, q.question_text, q.correct_answer, 
+            SELECT q.id, q.exam_id, q.question_text, q.type, q.correct_answer, q.partial_credit, q.points,
                    q.created_at, q.updated_at
             FROM questions q
             WHERE q.exam_id = $1
@@ -282,7 +305,10 @@ func (r *postgresRepository) GetExamQuestions(ctx context.Context, filter domain
 			&question.ID,
 			&question.ExamID,
 			&question.QuestionText,
+			&question.Type,
 			&question.CorrectAnswer,
+			&question.PartialCredit,
+			&question.Points,
 			&question.CreatedAt,
 			&question.UpdatedAt,
 		)
@@ -298,7 +324,7 @@ func (r *postgresRepository) GetExamQuestions(ctx context.Context, filter domain
             SELECT id, text
             FROM choices
             WHERE question_id = $1
-            ORDER BY id`
+            ORDER BY position, id`
 
 		rows, err := r.db.QueryContext(ctx, choiceQuery, q.ID)
 		if err != nil {
@@ -331,3 +357,98 @@ func (r *postgresRepository) CountExamQuestions(ctx context.Context, examID stri
 	}
 	return count, nil
 }
+
+// GetSessionQuestions mengembalikan soal yang sudah dibekukan untuk sesi,
+// sesuai urutan saat sesi dimulai
+func (r *postgresRepository) GetSessionQuestions(ctx context.Context, sessionID string) ([]*domain.Question, error) {
+	query := `
+        SELECT q.id, q.exam_id, q.question_text, q.type, q.correct_answer, q.partial_credit, q.points,
+               q.created_at, q.updated_at
+        FROM session_questions sq
+        JOIN questions q ON q.id = sq.question_id
+        WHERE sq.session_id = $1
+        ORDER BY sq.position`
+
+	rows, err := r.db.QueryContext(ctx, query, sessionID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get session questions")
+	}
+	defer rows.Close()
+
+	var questions []*domain.Question
+	for rows.Next() {
+		question := &domain.Question{}
+		err := rows.Scan(
+			&question.ID,
+			&question.ExamID,
+			&question.QuestionText,
+			&question.Type,
+			&question.CorrectAnswer,
+			&question.PartialCredit,
+			&question.Points,
+			&question.CreatedAt,
+			&question.UpdatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan question")
+		}
+		questions = append(questions, question)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to get session questions")
+	}
+
+	if len(questions) == 0 {
+		return nil, repository.ErrSessionNotFound
+	}
+
+	for _, q := range questions {
+		q.Choices, err = r.getChoices(ctx, q.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return questions, nil
+}
+
+func (r *postgresRepository) getChoices(ctx context.Context, questionID string) ([]domain.Choice, error) {
+	choiceQuery := `
+        SELECT id, text
+        FROM choices
+        WHERE question_id = $1
+        ORDER BY position, id`
+
+	rows, err := r.db.QueryContext(ctx, choiceQuery, questionID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get choices")
+	}
+	defer rows.Close()
+
+	var choices []domain.Choice
+	for rows.Next() {
+		var choice domain.Choice
+		if err := rows.Scan(&choice.ID, &choice.Text); err != nil {
+			return nil, errors.Wrap(err, "failed to scan choice")
+		}
+		choices = append(choices, choice)
+	}
+
+	return choices, rows.Err()
+}
+
+// GetSessionChoiceSeed mengembalikan seed pengacakan pilihan untuk sesi (0 jika tidak diacak)
+func (r *postgresRepository) GetSessionChoiceSeed(ctx context.Context, sessionID string) (int64, error) {
+	var seed int64
+	err := r.db.QueryRowContext(ctx,
+		"SELECT choice_seed FROM exam_sessions WHERE id = $1",
+		sessionID,
+	).Scan(&seed)
+	if err == sql.ErrNoRows {
+		return 0, repository.ErrSessionNotFound
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get session choice seed")
+	}
+	return seed, nil
+}
@@ -1,8 +1,15 @@
+CREATE TYPE question_type AS ENUM ('MULTIPLE_CHOICE', 'MULTIPLE_ANSWER', 'ESSAY', 'SHORT_ANSWER');
+
 CREATE TABLE questions (
                            id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
                            exam_id UUID NOT NULL REFERENCES exams(id) ON DELETE CASCADE,
                            question_text TEXT NOT NULL,
-                           correct_answer VARCHAR(1) NOT NULL,
+                           type question_type NOT NULL DEFAULT 'MULTIPLE_CHOICE',
+                           -- Huruf kanonik, dipisah koma untuk MULTIPLE_ANSWER (misal "A,C");
+                           -- contoh jawaban untuk ESSAY dan SHORT_ANSWER
+                           correct_answer TEXT NOT NULL,
+                           partial_credit BOOLEAN NOT NULL DEFAULT FALSE,
+                           points DECIMAL(6,2) NOT NULL DEFAULT 1,
                            created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
                            updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
 );
@@ -11,8 +18,9 @@ CREATE TABLE choices (
                          id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
                          question_id UUID NOT NULL REFERENCES questions(id) ON DELETE CASCADE,
                          text TEXT NOT NULL,
+                         position INTEGER NOT NULL DEFAULT 0,
                          created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
 );
 
-CREATE INDEX idx_question_exam ON questions(exam_id);
+CREATE INDEX idx_question_exam ON questions(exam_id, created_at, id);
 CREATE INDEX idx_choices_question ON choices(question_id);
\ No newline at end of file
@@ -5,19 +5,24 @@ import (
 	"errors"
 
 	"github.com/ApesJs/cbt-exam/internal/question/domain"
+	"github.com/ApesJs/cbt-exam/pkg/pagination"
 )
 
 type QuestionRepository interface {
 	// Basic CRUD
 	Create(ctx context.Context, question *domain.Question) error
 	GetByID(ctx context.Context, id string) (*domain.Question, error)
-	List(ctx context.Context, examID string, limit int32, offset int32) ([]*domain.Question, error)
+	List(ctx context.Context, examID string, limit int32, after *pagination.Cursor) ([]*domain.Question, error)
 	Update(ctx context.Context, question *domain.Question) error
 	Delete(ctx context.Context, id string) error
 
 	// Specific to exam questions
 	GetExamQuestions(ctx context.Context, filter domain.QuestionFilter) ([]*domain.Question, error)
 	CountExamQuestions(ctx context.Context, examID string) (int32, error)
+
+	// Specific to exam sessions
+	GetSessionQuestions(ctx context.Context, sessionID string) ([]*domain.Question, error)
+	GetSessionChoiceSeed(ctx context.Context, sessionID string) (int64, error)
 }
 
 // Errors
@@ -25,4 +30,5 @@ var (
 	ErrQuestionNotFound = errors.New("question not found")
 	ErrInvalidQuestion  = errors.New("invalid question data")
 	ErrExamNotFound     = errors.New("exam not found")
+	ErrSessionNotFound  = errors.New("session not found")
 )
@@ -9,6 +9,7 @@ import (
 	questionv1 "github.com/ApesJs/cbt-exam/api/proto/question/v1"
 	"github.com/ApesJs/cbt-exam/internal/question/domain"
 	"github.com/ApesJs/cbt-exam/internal/question/repository"
+	"github.com/ApesJs/cbt-exam/pkg/pagination"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -45,7 +46,10 @@ func (s *questionService) CreateQuestion(ctx context.Context, req *questionv1.Cr
 	question := &domain.Question{
 		ExamID:        req.ExamId,
 		QuestionText:  req.QuestionText,
+		Type:          convertTypeToDomain(req.Type),
 		CorrectAnswer: req.CorrectAnswer,
+		PartialCredit: req.PartialCredit,
+		Points:        float64(req.Points),
 	}
 
 	for _, c := range req.Choices {
@@ -54,6 +58,10 @@ func (s *questionService) CreateQuestion(ctx context.Context, req *questionv1.Cr
 		})
 	}
 
+	if err := question.Normalize(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	if err := s.repo.Create(ctx, question); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create question: %v", err)
 	}
@@ -74,26 +82,36 @@ func (s *questionService) GetQuestion(ctx context.Context, req *questionv1.GetQu
 }
 
 func (s *questionService) ListQuestions(ctx context.Context, req *questionv1.ListQuestionsRequest) (*questionv1.ListQuestionsResponse, error) {
-	questions, err := s.repo.List(ctx, req.ExamId, req.PageSize, int32(len(req.PageToken)))
+	after, err := pagination.Decode(req.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Ambil satu baris lebih untuk mengetahui apakah masih ada halaman berikutnya
+	pageSize := pagination.PageSize(req.PageSize)
+	questions, err := s.repo.List(ctx, req.ExamId, pageSize+1, after)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list questions: %v", err)
 	}
 
-	var protoQuestions []*questionv1.Question
+	resp := &questionv1.ListQuestionsResponse{}
+	if len(questions) > int(pageSize) {
+		questions = questions[:pageSize]
+		last := questions[len(questions)-1]
+		resp.NextPageToken = pagination.Encode(pagination.Cursor{Time: last.CreatedAt, ID: last.ID})
+	}
 	for _, q := range questions {
-		protoQuestions = append(protoQuestions, convertDomainToProto(q))
+		resp.Questions = append(resp.Questions, convertDomainToProto(q))
 	}
 
-	// Simple pagination using last question's ID as next page token
-	var nextPageToken string
-	if len(questions) == int(req.PageSize) {
-		nextPageToken = questions[len(questions)-1].ID
+	if req.IncludeTotal {
+		resp.TotalSize, err = s.repo.CountExamQuestions(ctx, req.ExamId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to count questions: %v", err)
+		}
 	}
 
-	return &questionv1.ListQuestionsResponse{
-		Questions:     protoQuestions,
-		NextPageToken: nextPageToken,
-	}, nil
+	return resp, nil
 }
 
 func (s *questionService) UpdateQuestion(ctx context.Context, req *questionv1.UpdateQuestionRequest) (*questionv1.Question, error) {
@@ -101,7 +119,10 @@ func (s *questionService) UpdateQuestion(ctx context.Context, req *questionv1.Up
 		ID:            req.Id,
 		ExamID:        req.Question.ExamId,
 		QuestionText:  req.Question.QuestionText,
+		Type:          convertTypeToDomain(req.Question.Type),
 		CorrectAnswer: req.Question.CorrectAnswer,
+		PartialCredit: req.Question.PartialCredit,
+		Points:        float64(req.Question.Points),
 	}
 
 	// Convert choices from proto to domain
@@ -112,6 +133,10 @@ func (s *questionService) UpdateQuestion(ctx context.Context, req *questionv1.Up
 		})
 	}
 
+	if err := question.Normalize(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	if err := s.repo.Update(ctx, question); err != nil {
 		if errors.Is(err, repository.ErrQuestionNotFound) {
 			return nil, status.Error(codes.NotFound, "question not found")
@@ -165,6 +190,34 @@ func (s *questionService) GetExamQuestions(ctx context.Context, req *questionv1.
 	}, nil
 }
 
+func (s *questionService) GetSessionQuestions(ctx context.Context, req *questionv1.GetSessionQuestionsRequest) (*questionv1.GetSessionQuestionsResponse, error) {
+	questions, err := s.repo.GetSessionQuestions(ctx, req.SessionId)
+	if err != nil {
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			return nil, status.Error(codes.NotFound, "session not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get session questions: %v", err)
+	}
+
+	seed, err := s.repo.GetSessionChoiceSeed(ctx, req.SessionId)
+	if err != nil {
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			return nil, status.Error(codes.NotFound, "session not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get session choice seed: %v", err)
+	}
+
+	var protoQuestions []*questionv1.DeliverableQuestion
+	for _, q := range questions {
+		q.ShuffleChoices(seed)
+		protoQuestions = append(protoQuestions, convertDomainToDeliverable(q))
+	}
+
+	return &questionv1.GetSessionQuestionsResponse{
+		Questions: protoQuestions,
+	}, nil
+}
+
 // Helper functions to convert between domain and proto models
 func convertDomainToProto(q *domain.Question) *questionv1.Question {
 	protoQuestion := &questionv1.Question{
@@ -172,6 +225,9 @@ func convertDomainToProto(q *domain.Question) *questionv1.Question {
 		ExamId:        q.ExamID,
 		QuestionText:  q.QuestionText,
 		CorrectAnswer: q.CorrectAnswer,
+		Type:          convertTypeToProto(q.Type),
+		PartialCredit: q.PartialCredit,
+		Points:        float32(q.Points),
 		//CreatedAt:     timestamppb.New(q.CreatedAt),
 	}
 
@@ -184,3 +240,52 @@ func convertDomainToProto(q *domain.Question) *questionv1.Question {
 
 	return protoQuestion
 }
+
+// convertDomainToDeliverable membuat tampilan soal untuk siswa tanpa kunci jawaban
+func convertDomainToDeliverable(q *domain.Question) *questionv1.DeliverableQuestion {
+	deliverable := &questionv1.DeliverableQuestion{
+		Id:           q.ID,
+		ExamId:       q.ExamID,
+		QuestionText: q.QuestionText,
+		Type:         convertTypeToProto(q.Type),
+		Points:       float32(q.Points),
+	}
+
+	for _, c := range q.Choices {
+		deliverable.Choices = append(deliverable.Choices, &questionv1.Choice{
+			Id:   c.ID,
+			Text: c.Text,
+		})
+	}
+
+	return deliverable
+}
+
+func convertTypeToProto(t domain.QuestionType) questionv1.QuestionType {
+	switch t {
+	case domain.QuestionTypeMultipleChoice:
+		return questionv1.QuestionType_QUESTION_TYPE_MULTIPLE_CHOICE
+	case domain.QuestionTypeMultipleAnswer:
+		return questionv1.QuestionType_QUESTION_TYPE_MULTIPLE_ANSWER
+	case domain.QuestionTypeEssay:
+		return questionv1.QuestionType_QUESTION_TYPE_ESSAY
+	case domain.QuestionTypeShortAnswer:
+		return questionv1.QuestionType_QUESTION_TYPE_SHORT_ANSWER
+	default:
+		return questionv1.QuestionType_QUESTION_TYPE_UNSPECIFIED
+	}
+}
+
+// convertTypeToDomain memetakan tipe soal; UNSPECIFIED dianggap pilihan ganda biasa
+func convertTypeToDomain(t questionv1.QuestionType) domain.QuestionType {
+	switch t {
+	case questionv1.QuestionType_QUESTION_TYPE_MULTIPLE_ANSWER:
+		return domain.QuestionTypeMultipleAnswer
+	case questionv1.QuestionType_QUESTION_TYPE_ESSAY:
+		return domain.QuestionTypeEssay
+	case questionv1.QuestionType_QUESTION_TYPE_SHORT_ANSWER:
+		return domain.QuestionTypeShortAnswer
+	default:
+		return domain.QuestionTypeMultipleChoice
+	}
+}
@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"math"
+
+	"github.com/ApesJs/cbt-exam/pkg/choiceset"
+	"github.com/ApesJs/cbt-exam/pkg/shuffle"
+)
+
+// ExamItem adalah soal ujian yang dianalisis
+type ExamItem struct {
+	QuestionID    string  `json:"question_id"`
+	QuestionText  string  `json:"question_text"`
+	QuestionType  string  `json:"question_type"`
+	CorrectAnswer string  `json:"correct_answer"`
+	Points        float64 `json:"points"`
+	ChoiceCount   int     `json:"choice_count"`
+}
+
+// ChoiceFrequency adalah berapa kali sebuah pilihan (huruf kanonik) dipilih
+type ChoiceFrequency struct {
+	Choice     string  `json:"choice"`
+	Count      int32   `json:"count"`
+	Proportion float64 `json:"proportion"`
+	Correct    bool    `json:"correct"`
+}
+
+// ItemAnalysis berisi tingkat kesukaran, daya beda, dan sebaran pilihan satu soal
+type ItemAnalysis struct {
+	ExamItem
+	// Responses adalah jumlah sesi yang mendapat soal ini
+	Responses int32 `json:"responses"`
+	Omitted   int32 `json:"omitted"`
+	// Difficulty (p-value) adalah rata-rata skor soal antara 0 dan 1
+	Difficulty float64 `json:"difficulty"`
+	// Discrimination adalah korelasi point-biserial antara skor soal dan skor
+	// total siswa tanpa soal tersebut
+	Discrimination float64           `json:"discrimination"`
+	Choices        []ChoiceFrequency `json:"choices"`
+}
+
+// AnalyzeItems menghitung analisis butir soal dari jawaban setiap sesi yang sudah
+// selesai. sessions dipetakan dari ID sesi ke jawaban sesi tersebut.
+func AnalyzeItems(items []ExamItem, sessions map[string][]Answer) []ItemAnalysis {
+	// Skor total tiap sesi, dipakai untuk daya beda
+	totals := make(map[string]float64, len(sessions))
+	for sessionID, answers := range sessions {
+		for _, answer := range answers {
+			totals[sessionID] += answer.Earned()
+		}
+	}
+
+	results := make([]ItemAnalysis, 0, len(items))
+	for _, item := range items {
+		analysis := ItemAnalysis{ExamItem: item}
+		counts := make([]int32, item.ChoiceCount)
+
+		var credits, rest []float64
+		for sessionID, answers := range sessions {
+			for _, answer := range answers {
+				if answer.QuestionID != item.QuestionID {
+					continue
+				}
+
+				analysis.Responses++
+				if !answer.IsAnswered() {
+					analysis.Omitted++
+				}
+				for _, letter := range choiceset.Parse(answer.StudentAnswer) {
+					if idx, ok := shuffle.Index(letter); ok && idx < len(counts) {
+						counts[idx]++
+					}
+				}
+
+				credits = append(credits, answer.Credit())
+				rest = append(rest, totals[sessionID]-answer.Earned())
+				break
+			}
+		}
+
+		analysis.Difficulty = mean(credits)
+		analysis.Discrimination = pearson(credits, rest)
+
+		key := choiceset.Parse(item.CorrectAnswer)
+		for i, count := range counts {
+			letter := shuffle.Letter(i)
+			frequency := ChoiceFrequency{Choice: letter, Count: count}
+			if analysis.Responses > 0 {
+				frequency.Proportion = float64(count) / float64(analysis.Responses)
+			}
+			for _, k := range key {
+				if k == letter {
+					frequency.Correct = true
+				}
+			}
+			analysis.Choices = append(analysis.Choices, frequency)
+		}
+
+		results = append(results, analysis)
+	}
+
+	return results
+}
+
+func mean(xs []float64) float64 {
+	if len(xs) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, x := range xs {
+		sum += x
+	}
+	return sum / float64(len(xs))
+}
+
+// pearson menghitung koefisien korelasi; 0 jika salah satu data tidak bervariasi
+func pearson(xs, ys []float64) float64 {
+	if len(xs) < 2 || len(xs) != len(ys) {
+		return 0
+	}
+
+	mx, my := mean(xs), mean(ys)
+	var sxy, sxx, syy float64
+	for i := range xs {
+		dx, dy := xs[i]-mx, ys[i]-my
+		sxy += dx * dy
+		sxx += dx * dx
+		syy += dy * dy
+	}
+	if sxx == 0 || syy == 0 {
+		return 0
+	}
+
+	return sxy / math.Sqrt(sxx*syy)
+}
@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+const (
+	// CollusionMinShared adalah jumlah minimal jawaban salah identik sebelum
+	// sepasang sesi dapat ditandai
+	CollusionMinShared = 3
+	// CollusionMaxProbability adalah peluang maksimal kecocokan terjadi secara
+	// kebetulan agar sepasang sesi ditandai
+	CollusionMaxProbability = 0.001
+)
+
+// CollusionStatus adalah hasil peninjauan guru atas pasangan yang ditandai
+type CollusionStatus string
+
+const (
+	CollusionPending   CollusionStatus = "PENDING"
+	CollusionConfirmed CollusionStatus = "CONFIRMED"
+	CollusionDismissed CollusionStatus = "DISMISSED"
+)
+
+// CollusionFlag adalah pasangan sesi dengan jawaban salah identik yang terlalu
+// banyak untuk terjadi secara kebetulan. SessionA selalu lebih kecil dari SessionB.
+type CollusionFlag struct {
+	ID        string `json:"id"`
+	ExamID    string `json:"exam_id"`
+	SessionA  string `json:"session_a"`
+	SessionB  string `json:"session_b"`
+	StudentA  string `json:"student_a"`
+	StudentB  string `json:"student_b"`
+	BothWrong int32  `json:"both_wrong"`
+	// SharedWrong adalah soal yang dijawab salah oleh keduanya dengan jawaban yang sama
+	SharedWrong int32 `json:"shared_wrong"`
+	// Expected adalah jumlah kecocokan yang diharapkan jika keduanya menjawab sendiri
+	Expected    float64         `json:"expected"`
+	Probability float64         `json:"probability"`
+	Status      CollusionStatus `json:"status"`
+	ReviewNote  string          `json:"review_note"`
+	ReviewedBy  string          `json:"reviewed_by"`
+	ReviewedAt  *time.Time      `json:"reviewed_at,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+// CollusionResult adalah hasil satu kali analisis kecurangan ujian
+type CollusionResult struct {
+	ExamID        string
+	Sessions      int32
+	PairsCompared int32
+	Flags         []CollusionFlag
+}
+
+// DetectCollusion membandingkan setiap pasangan sesi. Untuk soal yang sama-sama
+// dijawab salah, peluang dua siswa memilih pengecoh yang sama dihitung dari
+// sebaran jawaban salah seluruh peserta. Jumlah kecocokan dibandingkan dengan
+// distribusi Poisson dengan rata-rata jumlah peluang tersebut. Soal teks bebas
+// tidak dihitung.
+func DetectCollusion(examID string, sessions map[string][]Answer) CollusionResult {
+	// Jawaban salah tiap sesi per soal
+	wrong := make(map[string]map[string]string, len(sessions))
+	// Sebaran jawaban salah per soal
+	distractors := make(map[string]map[string]int)
+	wrongCount := make(map[string]int)
+	for sessionID, answers := range sessions {
+		wrong[sessionID] = make(map[string]string)
+		for _, answer := range answers {
+			if answer.IsManuallyGraded() || !answer.IsAnswered() || answer.Credit() >= 1 {
+				continue
+			}
+			wrong[sessionID][answer.QuestionID] = answer.StudentAnswer
+			if distractors[answer.QuestionID] == nil {
+				distractors[answer.QuestionID] = make(map[string]int)
+			}
+			distractors[answer.QuestionID][answer.StudentAnswer]++
+			wrongCount[answer.QuestionID]++
+		}
+	}
+
+	// Peluang dua jawaban salah acak pada soal yang sama identik
+	match := make(map[string]float64, len(distractors))
+	for questionID, counts := range distractors {
+		total := float64(wrongCount[questionID])
+		for _, n := range counts {
+			f := float64(n) / total
+			match[questionID] += f * f
+		}
+	}
+
+	ids := make([]string, 0, len(sessions))
+	for sessionID := range sessions {
+		ids = append(ids, sessionID)
+	}
+	sort.Strings(ids)
+
+	result := CollusionResult{
+		ExamID:   examID,
+		Sessions: int32(len(ids)),
+	}
+	for i, a := range ids {
+		for _, b := range ids[i+1:] {
+			result.PairsCompared++
+
+			flag := CollusionFlag{ExamID: examID, SessionA: a, SessionB: b, Status: CollusionPending}
+			for questionID, answerA := range wrong[a] {
+				answerB, ok := wrong[b][questionID]
+				if !ok {
+					continue
+				}
+				flag.BothWrong++
+				flag.Expected += match[questionID]
+				if answerA == answerB {
+					flag.SharedWrong++
+				}
+			}
+			if flag.SharedWrong < CollusionMinShared {
+				continue
+			}
+
+			flag.Probability = poissonTail(flag.Expected, int(flag.SharedWrong))
+			if flag.Probability <= CollusionMaxProbability {
+				result.Flags = append(result.Flags, flag)
+			}
+		}
+	}
+
+	return result
+}
+
+// poissonTail menghitung P(X >= k) untuk X ~ Poisson(lambda)
+func poissonTail(lambda float64, k int) float64 {
+	if k <= 0 {
+		return 1
+	}
+	if lambda <= 0 {
+		return 0
+	}
+
+	term := math.Exp(-lambda)
+	below := term
+	for i := 1; i < k; i++ {
+		term *= lambda / float64(i)
+		below += term
+	}
+
+	return math.Max(0, 1-below)
+}
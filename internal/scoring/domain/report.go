@@ -0,0 +1,25 @@
+package domain
+
+// SessionReport adalah isi rapor hasil ujian satu siswa
+type SessionReport struct {
+	SessionID   string
+	ExamID      string
+	ExamTitle   string
+	Subject     string
+	StudentID   string
+	StudentName string
+	ClassID     string
+	Score       *ExamScore
+	// Kosong jika rapor tidak menyertakan rincian soal
+	Questions []ReportQuestion
+}
+
+// ReportQuestion adalah soal beserta jawaban siswa dan kuncinya. Choices
+// disusun dalam urutan kanonik, sehingga huruf pada jawaban dan kunci sesuai
+// dengan urutan yang dicetak, bukan urutan acak yang dilihat siswa.
+type ReportQuestion struct {
+	Number  int
+	Text    string
+	Choices []string
+	Answer  Answer
+}
@@ -1,33 +1,409 @@
 package domain
 
 import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
 	"time"
+
+	"github.com/ApesJs/cbt-exam/pkg/choiceset"
+)
+
+type ScoreStatus string
+
+const (
+	// ScoreStatusProvisional berarti masih ada jawaban teks bebas yang belum dinilai
+	ScoreStatusProvisional ScoreStatus = "PROVISIONAL"
+	ScoreStatusFinal       ScoreStatus = "FINAL"
 )
 
+// ApplyScale menentukan kelulusan dan predikat berdasarkan skala penilaian;
+// tanpa skala, Passed dan Grade dikosongkan. Skala selalu dalam persen (0-100)
+// sehingga dibandingkan dengan Percentage, bukan Score yang satuannya mengikuti
+// kebijakan nilai ujian.
+func (s *ExamScore) ApplyScale(scale *GradingScale) {
+	s.Passed = nil
+	s.Grade = ""
+	if scale == nil {
+		return
+	}
+
+	percentage := s.Percentage()
+	passed := percentage >= scale.PassMark
+	s.Passed = &passed
+	s.Grade = scale.GradeFor(percentage)
+}
+
+// Percentage mengembalikan poin yang diperoleh sebagai persen dari poin maksimal
+func (s *ExamScore) Percentage() float64 {
+	if s.MaxPoints <= 0 {
+		return 0
+	}
+	return float64(s.RawPoints) / float64(s.MaxPoints) * 100
+}
+
+// GradeBand adalah batas bawah nilai untuk sebuah predikat (misal A >= 90)
+type GradeBand struct {
+	Grade    string  `json:"grade"`
+	MinScore float64 `json:"min_score"`
+}
+
+// GradingScale adalah KKM dan predikat nilai untuk satu ujian atau satu mata
+// pelajaran, dalam persen (0-100). Skala ujian lebih diutamakan daripada skala
+// mata pelajaran.
+type GradingScale struct {
+	ID       string      `json:"id"`
+	ExamID   string      `json:"exam_id,omitempty"`
+	Subject  string      `json:"subject,omitempty"`
+	PassMark float64     `json:"pass_mark"`
+	Bands    []GradeBand `json:"bands"`
+}
+
+// Validate memeriksa skala dan mengurutkan predikat dari batas tertinggi
+func (g *GradingScale) Validate() error {
+	if (g.ExamID == "") == (g.Subject == "") {
+		return errors.New("grading scale must target either an exam or a subject")
+	}
+	if g.PassMark < 0 || g.PassMark > 100 {
+		return errors.New("pass mark must be between 0 and 100")
+	}
+
+	seen := make(map[string]bool)
+	for _, band := range g.Bands {
+		if band.Grade == "" {
+			return errors.New("grade band requires a grade")
+		}
+		if seen[band.Grade] {
+			return fmt.Errorf("duplicate grade %q", band.Grade)
+		}
+		if band.MinScore < 0 || band.MinScore > 100 {
+			return fmt.Errorf("minimum score of grade %q must be between 0 and 100", band.Grade)
+		}
+		seen[band.Grade] = true
+	}
+
+	sort.Slice(g.Bands, func(i, j int) bool {
+		return g.Bands[i].MinScore > g.Bands[j].MinScore
+	})
+	for i := 1; i < len(g.Bands); i++ {
+		if g.Bands[i].MinScore == g.Bands[i-1].MinScore {
+			return fmt.Errorf("grades %q and %q share the same minimum score", g.Bands[i-1].Grade, g.Bands[i].Grade)
+		}
+	}
+
+	return nil
+}
+
+// GradeFor mengembalikan predikat tertinggi yang batas bawahnya terpenuhi
+func (g *GradingScale) GradeFor(score float64) string {
+	for _, band := range g.Bands {
+		if score >= band.MinScore {
+			return band.Grade
+		}
+	}
+	return ""
+}
+
+// ScoreFilter menyaring daftar nilai; Passed nil berarti semua nilai
+type ScoreFilter struct {
+	ExamID string
+	Passed *bool
+}
+
+// ScoreChangeReason dicatat di score_history saat nilai yang ada ditimpa
+type ScoreChangeReason string
+
+const (
+	ScoreChangeRecalculate  ScoreChangeReason = "RECALCULATE"
+	ScoreChangeManualGrade  ScoreChangeReason = "MANUAL_GRADE"
+	ScoreChangeRegrade      ScoreChangeReason = "REGRADE"
+	ScoreChangeGradingScale ScoreChangeReason = "GRADING_SCALE"
+)
+
+// ScoreChange membandingkan nilai siswa sebelum dan sesudah regrade
+type ScoreChange struct {
+	SessionID string     `json:"session_id"`
+	StudentID string     `json:"student_id"`
+	Previous  *ExamScore `json:"previous"`
+	Current   *ExamScore `json:"current"`
+}
+
+// Changed melaporkan apakah regrade mengubah nilai atau rinciannya
+func (c ScoreChange) Changed() bool {
+	if c.Previous == nil {
+		return true
+	}
+	return c.Previous.Score != c.Current.Score ||
+		c.Previous.RawPoints != c.Current.RawPoints ||
+		c.Previous.CorrectAnswers != c.Current.CorrectAnswers ||
+		c.Previous.WrongAnswers != c.Current.WrongAnswers ||
+		c.Previous.Status != c.Current.Status ||
+		!samePassed(c.Previous.Passed, c.Current.Passed) ||
+		c.Previous.Grade != c.Current.Grade
+}
+
+func samePassed(a, b *bool) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// ScorePolicy mengikuti kebijakan nilai pada ujian
+type ScorePolicy string
+
+const (
+	ScorePolicyPercentage ScorePolicy = "PERCENTAGE"
+	ScorePolicyRawPoints  ScorePolicy = "RAW_POINTS"
+	ScorePolicyScaled     ScorePolicy = "SCALED"
+)
+
+type PenaltyType string
+
+const (
+	PenaltyNone     PenaltyType = "NONE"
+	PenaltyFixed    PenaltyType = "FIXED"
+	PenaltyFraction PenaltyType = "FRACTION"
+)
+
+// PenaltyRule adalah pengurangan poin untuk jawaban salah
+type PenaltyRule struct {
+	Type          PenaltyType
+	Value         float64
+	AllowNegative bool
+}
+
+// For mengembalikan pengurangan poin untuk satu jawaban. Hanya jawaban pilihan
+// yang salah seluruhnya yang dikenai penalti; jawaban kosong, sebagian benar,
+// dan teks bebas tidak.
+func (r PenaltyRule) For(a Answer) float64 {
+	if !a.IsAnswered() || a.IsManuallyGraded() || a.Credit() > 0 {
+		return 0
+	}
+
+	switch r.Type {
+	case PenaltyFixed:
+		return r.Value
+	case PenaltyFraction:
+		return r.Value * a.Points
+	default:
+		return 0
+	}
+}
+
+// ScoringPolicy adalah aturan penilaian yang diambil dari ujian
+type ScoringPolicy struct {
+	Policy   ScorePolicy
+	ScoreMax float64
+	Penalty  PenaltyRule
+}
+
 type ExamScore struct {
-	ID              string    `json:"id"`
-	ExamID          string    `json:"exam_id"`
-	SessionID       string    `json:"session_id"`
-	StudentID       string    `json:"student_id"`
-	TotalQuestions  int32     `json:"total_questions"`
-	CorrectAnswers  int32     `json:"correct_answers"`
-	WrongAnswers    int32     `json:"wrong_answers"`
-	UnansweredCount int32     `json:"unanswered"`
-	Score           float32   `json:"score"`
-	CreatedAt       time.Time `json:"created_at"`
-	UpdatedAt       time.Time `json:"updated_at"`
-}
-
-// CalculateScore menghitung nilai berdasarkan jawaban benar
-func (s *ExamScore) CalculateScore() {
-	if s.TotalQuestions > 0 {
-		s.Score = float32(s.CorrectAnswers) / float32(s.TotalQuestions) * 100
+	ID              string      `json:"id"`
+	ExamID          string      `json:"exam_id"`
+	SessionID       string      `json:"session_id"`
+	StudentID       string      `json:"student_id"`
+	TotalQuestions  int32       `json:"total_questions"`
+	CorrectAnswers  int32       `json:"correct_answers"`
+	WrongAnswers    int32       `json:"wrong_answers"`
+	UnansweredCount int32       `json:"unanswered"`
+	RawPoints       float32     `json:"raw_points"`
+	PenaltyPoints   float32     `json:"penalty_points"`
+	MaxPoints       float32     `json:"max_points"`
+	Score           float32     `json:"score"`
+	Status          ScoreStatus `json:"status"`
+	PendingGrading  int32       `json:"pending_grading"`
+	Passed          *bool       `json:"passed,omitempty"`
+	Grade           string      `json:"grade"`
+	CreatedAt       time.Time   `json:"created_at"`
+	UpdatedAt       time.Time   `json:"updated_at"`
+}
+
+// ScoreExportRow adalah nilai siswa beserta identitasnya untuk buku nilai
+type ScoreExportRow struct {
+	Score       *ExamScore `json:"score"`
+	StudentName string     `json:"student_name"`
+	ClassID     string     `json:"class_id"`
+}
+
+// Tally menghitung jumlah jawaban benar, salah, kosong, serta poin mentah dari
+// jawaban sesi setelah dikurangi penalti. Jawaban yang hanya mendapat nilai
+// sebagian dihitung sebagai salah namun tetap menyumbang poinnya. Nilai berstatus
+// PROVISIONAL selama masih ada jawaban teks bebas yang belum dinilai guru.
+func (s *ExamScore) Tally(answers []Answer, penalty PenaltyRule) {
+	s.TotalQuestions = int32(len(answers))
+	s.CorrectAnswers = 0
+	s.UnansweredCount = 0
+	s.PendingGrading = 0
+
+	var earned, deducted, possible float64
+	for _, answer := range answers {
+		possible += answer.Points
+		if !answer.IsAnswered() {
+			s.UnansweredCount++
+			continue
+		}
+		if answer.IsPending() {
+			s.PendingGrading++
+		}
+		if answer.Credit() == 1 {
+			s.CorrectAnswers++
+		}
+		earned += answer.Earned()
+		deducted += penalty.For(answer)
+	}
+
+	raw := earned - deducted
+	if raw < 0 && !penalty.AllowNegative {
+		raw = 0
 	}
+
+	s.RawPoints = float32(raw)
+	s.PenaltyPoints = float32(deducted)
+	s.MaxPoints = float32(possible)
 	s.WrongAnswers = s.TotalQuestions - s.CorrectAnswers - s.UnansweredCount
+
+	s.Status = ScoreStatusFinal
+	if s.PendingGrading > 0 {
+		s.Status = ScoreStatusProvisional
+	}
+}
+
+// CalculateScore mengubah poin mentah menjadi nilai akhir sesuai kebijakan ujian
+func (s *ExamScore) CalculateScore(policy ScoringPolicy) {
+	if policy.Policy == ScorePolicyRawPoints {
+		s.Score = s.RawPoints
+		return
+	}
+
+	scale := float32(100)
+	if policy.Policy == ScorePolicyScaled && policy.ScoreMax > 0 {
+		scale = float32(policy.ScoreMax)
+	}
+
+	s.Score = 0
+	if s.MaxPoints > 0 {
+		s.Score = s.RawPoints / s.MaxPoints * scale
+	}
+}
+
+// SessionInfo berisi data sesi yang dibutuhkan untuk menyimpan nilai
+type SessionInfo struct {
+	SessionID string `json:"session_id"`
+	ExamID    string `json:"exam_id"`
+	StudentID string `json:"student_id"`
+	Status    string `json:"status"`
+}
+
+// IsClosed melaporkan apakah sesi sudah selesai dikerjakan
+func (s *SessionInfo) IsClosed() bool {
+	return s.Status == "FINISHED" || s.Status == "TIMEOUT"
 }
 
 type Answer struct {
 	QuestionID    string `json:"question_id"`
+	QuestionType  string `json:"question_type"`
 	CorrectAnswer string `json:"correct_answer"`
 	StudentAnswer string `json:"student_answer"`
+	AnswerText    string `json:"answer_text"`
+	PartialCredit bool   `json:"partial_credit"`
+	// Points adalah bobot soal
+	Points float64 `json:"points"`
+	// Graded dan AwardedPoints berasal dari penilaian manual guru
+	Graded        bool    `json:"graded"`
+	AwardedPoints float64 `json:"awarded_points"`
+}
+
+// IsManuallyGraded melaporkan apakah jawaban berupa teks bebas yang dinilai guru
+func (a Answer) IsManuallyGraded() bool {
+	return a.QuestionType == "ESSAY" || a.QuestionType == "SHORT_ANSWER"
+}
+
+func (a Answer) IsAnswered() bool {
+	if a.IsManuallyGraded() {
+		return a.AnswerText != ""
+	}
+	return a.StudentAnswer != ""
+}
+
+// IsPending melaporkan apakah jawaban masih menunggu penilaian guru
+func (a Answer) IsPending() bool {
+	return a.IsManuallyGraded() && a.IsAnswered() && !a.Graded
+}
+
+// Earned mengembalikan poin yang diperoleh jawaban ini
+func (a Answer) Earned() float64 {
+	if a.IsManuallyGraded() {
+		return a.AwardedPoints
+	}
+	return a.Credit() * a.Points
+}
+
+// Credit mengembalikan nilai jawaban antara 0 dan 1. Soal MULTIPLE_ANSWER tanpa
+// partial credit bernilai 1 hanya jika himpunan pilihannya sama persis; dengan
+// partial credit, tiap pilihan benar menambah dan tiap pilihan salah mengurangi
+// 1/jumlah kunci, dengan batas bawah 0.
+func (a Answer) Credit() float64 {
+	if a.IsManuallyGraded() {
+		if a.Points <= 0 {
+			return 0
+		}
+		return a.AwardedPoints / a.Points
+	}
+
+	key := choiceset.Parse(a.CorrectAnswer)
+	selected := choiceset.Parse(a.StudentAnswer)
+	if len(key) == 0 || len(selected) == 0 {
+		return 0
+	}
+
+	if !a.PartialCredit || a.QuestionType != "MULTIPLE_ANSWER" {
+		if slices.Equal(key, selected) {
+			return 1
+		}
+		return 0
+	}
+
+	hits := 0
+	for _, letter := range selected {
+		if slices.Contains(key, letter) {
+			hits++
+		} else {
+			hits--
+		}
+	}
+
+	return max(0, float64(hits)/float64(len(key)))
+}
+
+// AnswerGrade adalah nilai manual dari guru untuk satu jawaban teks bebas
+type AnswerGrade struct {
+	ID         string    `json:"id"`
+	SessionID  string    `json:"session_id"`
+	QuestionID string    `json:"question_id"`
+	Points     float64   `json:"points"`
+	Comment    string    `json:"comment"`
+	GradedAt   time.Time `json:"graded_at"`
+}
+
+// PendingAnswer adalah jawaban teks bebas yang menunggu dinilai
+type PendingAnswer struct {
+	AnswerID     string    `json:"answer_id"`
+	SessionID    string    `json:"session_id"`
+	ExamID       string    `json:"exam_id"`
+	StudentID    string    `json:"student_id"`
+	QuestionID   string    `json:"question_id"`
+	QuestionType string    `json:"question_type"`
+	QuestionText string    `json:"question_text"`
+	AnswerText   string    `json:"answer_text"`
+	AnsweredAt   time.Time `json:"answered_at"`
+}
+
+// SessionEvent adalah event penutupan sesi dari SessionService yang memicu penilaian
+type SessionEvent struct {
+	ID        string `json:"id"`
+	SessionID string `json:"session_id"`
+	Type      string `json:"event_type"`
+	Attempts  int32  `json:"attempts"`
 }
@@ -0,0 +1,179 @@
+package domain
+
+import (
+	"math"
+	"sort"
+)
+
+// DefaultHistogramBuckets adalah jumlah kelompok histogram jika tidak ditentukan
+const DefaultHistogramBuckets = 10
+
+// ScoreSample adalah nilai tersimpan seorang siswa beserta kelasnya
+type ScoreSample struct {
+	StudentID string
+	// Kosong jika siswa tidak tercatat di exam_student_status
+	ClassID   string
+	Score     float64
+	MaxPoints float64
+}
+
+// Distribution adalah ringkasan statistik sekumpulan nilai
+type Distribution struct {
+	Count  int32   `json:"count"`
+	Mean   float64 `json:"mean"`
+	Median float64 `json:"median"`
+	StdDev float64 `json:"std_dev"`
+	Min    float64 `json:"min"`
+	Max    float64 `json:"max"`
+}
+
+// HistogramBucket menghitung nilai dalam rentang [Lower, Upper); bucket terakhir
+// juga memuat Upper
+type HistogramBucket struct {
+	Lower float64 `json:"lower"`
+	Upper float64 `json:"upper"`
+	Count int32   `json:"count"`
+}
+
+// ClassStatistics adalah ringkasan nilai untuk satu kelas
+type ClassStatistics struct {
+	ClassID      string       `json:"class_id"`
+	Distribution Distribution `json:"distribution"`
+}
+
+// ExamStatistics adalah statistik nilai satu ujian
+type ExamStatistics struct {
+	ExamID       string            `json:"exam_id"`
+	Distribution Distribution      `json:"distribution"`
+	Histogram    []HistogramBucket `json:"histogram"`
+	Classes      []ClassStatistics `json:"classes"`
+	// KR20 adalah koefisien reliabilitas Kuder-Richardson 20; untuk soal dengan
+	// nilai parsial hasilnya sama dengan Cronbach's alpha
+	KR20 float64 `json:"kr20"`
+}
+
+// Describe menghitung jumlah, rata-rata, median, simpangan baku (populasi),
+// serta nilai terendah dan tertinggi
+func Describe(scores []float64) Distribution {
+	if len(scores) == 0 {
+		return Distribution{}
+	}
+
+	sorted := append([]float64(nil), scores...)
+	sort.Float64s(sorted)
+
+	d := Distribution{
+		Count: int32(len(sorted)),
+		Mean:  mean(sorted),
+		Min:   sorted[0],
+		Max:   sorted[len(sorted)-1],
+	}
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		d.Median = (sorted[mid-1] + sorted[mid]) / 2
+	} else {
+		d.Median = sorted[mid]
+	}
+	d.StdDev = math.Sqrt(variance(sorted))
+
+	return d
+}
+
+// Histogram membagi rentang [lower, upper] menjadi sejumlah kelompok sama lebar
+func Histogram(scores []float64, lower, upper float64, buckets int) []HistogramBucket {
+	if buckets <= 0 {
+		buckets = DefaultHistogramBuckets
+	}
+	if upper <= lower {
+		upper = lower + 1
+	}
+
+	width := (upper - lower) / float64(buckets)
+	histogram := make([]HistogramBucket, buckets)
+	for i := range histogram {
+		histogram[i].Lower = lower + float64(i)*width
+		histogram[i].Upper = lower + float64(i+1)*width
+	}
+	histogram[buckets-1].Upper = upper
+
+	for _, score := range scores {
+		i := int((score - lower) / width)
+		if i < 0 {
+			i = 0
+		}
+		if i >= buckets {
+			i = buckets - 1
+		}
+		histogram[i].Count++
+	}
+
+	return histogram
+}
+
+// KR20 menghitung reliabilitas dari skor soal (0 sampai 1) setiap sesi. Hanya
+// soal yang diterima semua sesi yang dihitung, agar sesi dengan soal acak
+// tetap sebanding.
+func KR20(sessions map[string][]Answer) float64 {
+	received := make(map[string]int)
+	for _, answers := range sessions {
+		for _, answer := range answers {
+			received[answer.QuestionID]++
+		}
+	}
+
+	var common []string
+	for questionID, n := range received {
+		if n == len(sessions) {
+			common = append(common, questionID)
+		}
+	}
+	k := len(common)
+	if k < 2 || len(sessions) < 2 {
+		return 0
+	}
+
+	isCommon := make(map[string]bool, k)
+	for _, questionID := range common {
+		isCommon[questionID] = true
+	}
+
+	credits := make(map[string][]float64, k)
+	var totals []float64
+	for _, answers := range sessions {
+		var total float64
+		for _, answer := range answers {
+			if !isCommon[answer.QuestionID] {
+				continue
+			}
+			credit := answer.Credit()
+			credits[answer.QuestionID] = append(credits[answer.QuestionID], credit)
+			total += credit
+		}
+		totals = append(totals, total)
+	}
+
+	totalVariance := variance(totals)
+	if totalVariance == 0 {
+		return 0
+	}
+
+	var itemVariance float64
+	for _, c := range credits {
+		itemVariance += variance(c)
+	}
+
+	return float64(k) / float64(k-1) * (1 - itemVariance/totalVariance)
+}
+
+func variance(xs []float64) float64 {
+	if len(xs) == 0 {
+		return 0
+	}
+	m := mean(xs)
+	var sum float64
+	for _, x := range xs {
+		sum += (x - m) * (x - m)
+	}
+	return sum / float64(len(xs))
+}
@@ -0,0 +1,140 @@
+// Package report mencetak rapor hasil ujian siswa ke PDF.
+package report
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/go-pdf/fpdf"
+
+	"github.com/ApesJs/cbt-exam/internal/scoring/domain"
+	"github.com/ApesJs/cbt-exam/pkg/choiceset"
+	"github.com/ApesJs/cbt-exam/pkg/shuffle"
+)
+
+const (
+	lineHeight = 6.0
+	labelWidth = 45.0
+)
+
+var unsafeFilename = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// Filename adalah nama file rapor: nama siswa (jika ada) dan ID sesi
+func Filename(r *domain.SessionReport) string {
+	name := strings.Trim(unsafeFilename.ReplaceAllString(r.StudentName, "_"), "_")
+	if name == "" {
+		return r.SessionID + ".pdf"
+	}
+	return name + "-" + r.SessionID + ".pdf"
+}
+
+// Render menulis rapor ke w dalam format PDF
+func Render(w io.Writer, r *domain.SessionReport) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	// Font bawaan hanya mendukung cp1252
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pdf.SetTitle(tr(r.ExamTitle), false)
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.MultiCell(0, 8, tr(r.ExamTitle), "", "C", false)
+	pdf.SetFont("Helvetica", "", 11)
+	pdf.CellFormat(0, lineHeight, tr(r.Subject), "", 1, "C", false, 0, "")
+	pdf.Ln(4)
+
+	field := func(label, value string) {
+		pdf.SetFont("Helvetica", "B", 11)
+		pdf.CellFormat(labelWidth, lineHeight, label, "", 0, "", false, 0, "")
+		pdf.SetFont("Helvetica", "", 11)
+		pdf.CellFormat(0, lineHeight, tr(value), "", 1, "", false, 0, "")
+	}
+
+	studentName := r.StudentName
+	if studentName == "" {
+		studentName = r.StudentID
+	}
+	field("Nama Siswa", studentName)
+	if r.ClassID != "" {
+		field("Kelas", r.ClassID)
+	}
+	pdf.Ln(2)
+
+	score := r.Score
+	field("Nilai", formatNumber(float64(score.Score)))
+	if score.Grade != "" {
+		field("Predikat", score.Grade)
+	}
+	if score.Passed != nil {
+		result := "Belum tuntas"
+		if *score.Passed {
+			result = "Tuntas"
+		}
+		field("Keterangan", result)
+	}
+	field("Poin", fmt.Sprintf("%s dari %s", formatNumber(float64(score.RawPoints)), formatNumber(float64(score.MaxPoints))))
+	if score.PenaltyPoints > 0 {
+		field("Pengurangan", formatNumber(float64(score.PenaltyPoints)))
+	}
+	field("Jumlah soal", fmt.Sprint(score.TotalQuestions))
+	field("Benar", fmt.Sprint(score.CorrectAnswers))
+	field("Salah", fmt.Sprint(score.WrongAnswers))
+	field("Tidak dijawab", fmt.Sprint(score.UnansweredCount))
+	if score.Status == domain.ScoreStatusProvisional {
+		pdf.Ln(2)
+		pdf.SetFont("Helvetica", "I", 10)
+		pdf.MultiCell(0, 5, fmt.Sprintf("Nilai sementara: %d jawaban masih menunggu penilaian guru.", score.PendingGrading), "", "", false)
+	}
+
+	if len(r.Questions) > 0 {
+		pdf.Ln(4)
+		pdf.SetFont("Helvetica", "B", 13)
+		pdf.CellFormat(0, 8, "Rincian Jawaban", "B", 1, "", false, 0, "")
+		pdf.Ln(2)
+		for _, q := range r.Questions {
+			renderQuestion(pdf, tr, q)
+		}
+	}
+
+	return pdf.Output(w)
+}
+
+func renderQuestion(pdf *fpdf.Fpdf, tr func(string) string, q domain.ReportQuestion) {
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.MultiCell(0, 5, tr(fmt.Sprintf("%d. %s", q.Number, q.Text)), "", "", false)
+
+	pdf.SetFont("Helvetica", "", 10)
+	for i, choice := range q.Choices {
+		pdf.MultiCell(0, 5, tr(fmt.Sprintf("    %s. %s", shuffle.Letter(i), choice)), "", "", false)
+	}
+
+	answer := q.Answer
+	studentAnswer := answer.StudentAnswer
+	if answer.IsManuallyGraded() {
+		studentAnswer = answer.AnswerText
+	}
+	if !answer.IsAnswered() {
+		studentAnswer = "-"
+	}
+	pdf.MultiCell(0, 5, tr("Jawaban siswa: "+studentAnswer), "", "", false)
+
+	if answer.IsManuallyGraded() {
+		if answer.CorrectAnswer != "" {
+			pdf.MultiCell(0, 5, tr("Contoh jawaban: "+answer.CorrectAnswer), "", "", false)
+		}
+	} else {
+		pdf.MultiCell(0, 5, "Kunci: "+choiceset.Format(choiceset.Parse(answer.CorrectAnswer)), "", "", false)
+	}
+
+	earned := "Belum dinilai"
+	if !answer.IsPending() {
+		earned = formatNumber(answer.Earned())
+	}
+	pdf.MultiCell(0, 5, fmt.Sprintf("Poin: %s / %s", earned, formatNumber(answer.Points)), "", "", false)
+	pdf.Ln(3)
+}
+
+func formatNumber(v float64) string {
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.2f", v), "0"), ".")
+}
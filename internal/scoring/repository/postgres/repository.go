@@ -3,12 +3,14 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
 
 	"github.com/ApesJs/cbt-exam/internal/scoring/domain"
 	"github.com/ApesJs/cbt-exam/internal/scoring/repository"
+	"github.com/ApesJs/cbt-exam/pkg/pagination"
 )
 
 type postgresRepository struct {
@@ -21,12 +23,58 @@ func NewPostgresRepository(db *sql.DB) repository.ScoringRepository {
 	}
 }
 
+const scoreColumns = `
+        id, exam_id, session_id, student_id, total_questions,
+        correct_answers, wrong_answers, unanswered, raw_points, penalty_points,
+        max_points, score, status, pending_grading, passed, grade,
+        created_at, updated_at`
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanScore membaca kolom scoreColumns; suffix dibaca sesudahnya jika query
+// memilih kolom tambahan di belakangnya
+func scanScore(row rowScanner, suffix ...interface{}) (*domain.ExamScore, error) {
+	score := &domain.ExamScore{}
+	var passed sql.NullBool
+	err := row.Scan(append([]interface{}{
+		&score.ID,
+		&score.ExamID,
+		&score.SessionID,
+		&score.StudentID,
+		&score.TotalQuestions,
+		&score.CorrectAnswers,
+		&score.WrongAnswers,
+		&score.UnansweredCount,
+		&score.RawPoints,
+		&score.PenaltyPoints,
+		&score.MaxPoints,
+		&score.Score,
+		&score.Status,
+		&score.PendingGrading,
+		&passed,
+		&score.Grade,
+		&score.CreatedAt,
+		&score.UpdatedAt,
+	}, suffix...)...)
+	if err != nil {
+		return nil, err
+	}
+	if passed.Valid {
+		score.Passed = &passed.Bool
+	}
+	return score, nil
+}
+
 func (r *postgresRepository) CreateScore(ctx context.Context, score *domain.ExamScore) error {
 	query := `
         INSERT INTO exam_scores (
             exam_id, session_id, student_id, total_questions,
-            correct_answers, wrong_answers, unanswered, score
-        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+            correct_answers, wrong_answers, unanswered, raw_points,
+            penalty_points, max_points, score, status, pending_grading,
+            passed, grade
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
         RETURNING id, created_at, updated_at`
 
 	err := r.db.QueryRowContext(ctx, query,
@@ -37,7 +85,14 @@ func (r *postgresRepository) CreateScore(ctx context.Context, score *domain.Exam
 		score.CorrectAnswers,
 		score.WrongAnswers,
 		score.UnansweredCount,
+		score.RawPoints,
+		score.PenaltyPoints,
+		score.MaxPoints,
 		score.Score,
+		score.Status,
+		score.PendingGrading,
+		score.Passed,
+		score.Grade,
 	).Scan(&score.ID, &score.CreatedAt, &score.UpdatedAt)
 
 	if err != nil {
@@ -52,30 +107,78 @@ func (r *postgresRepository) CreateScore(ctx context.Context, score *domain.Exam
 	return nil
 }
 
-func (r *postgresRepository) GetScore(ctx context.Context, id string) (*domain.ExamScore, error) {
-	score := &domain.ExamScore{}
+// UpdateScore menimpa nilai yang ada; nilai lama disalin ke score_history
+// beserta alasannya dalam transaksi yang sama
+func (r *postgresRepository) UpdateScore(ctx context.Context, score *domain.ExamScore, reason domain.ScoreChangeReason) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
 
-	query := `
+	historyQuery := `
+        INSERT INTO score_history (
+            score_id, exam_id, session_id, student_id, total_questions,
+            correct_answers, wrong_answers, unanswered, raw_points,
+            penalty_points, max_points, score, status, passed, grade, reason
+        )
         SELECT id, exam_id, session_id, student_id, total_questions,
-               correct_answers, wrong_answers, unanswered, score,
-               created_at, updated_at
+               correct_answers, wrong_answers, unanswered, raw_points,
+               penalty_points, max_points, score, status, passed, grade, $2
         FROM exam_scores
         WHERE id = $1`
 
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&score.ID,
-		&score.ExamID,
-		&score.SessionID,
-		&score.StudentID,
-		&score.TotalQuestions,
-		&score.CorrectAnswers,
-		&score.WrongAnswers,
-		&score.UnansweredCount,
-		&score.Score,
-		&score.CreatedAt,
-		&score.UpdatedAt,
-	)
+	result, err := tx.ExecContext(ctx, historyQuery, score.ID, reason)
+	if err != nil {
+		return errors.Wrap(err, "failed to record score history")
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return repository.ErrScoreNotFound
+	}
+
+	query := `
+        UPDATE exam_scores
+        SET session_id = $1, total_questions = $2, correct_answers = $3,
+            wrong_answers = $4, unanswered = $5, raw_points = $6,
+            penalty_points = $7, max_points = $8, score = $9, status = $10,
+            pending_grading = $11, passed = $12, grade = $13,
+            updated_at = CURRENT_TIMESTAMP
+        WHERE id = $14
+        RETURNING created_at, updated_at`
+
+	err = tx.QueryRowContext(ctx, query,
+		score.SessionID,
+		score.TotalQuestions,
+		score.CorrectAnswers,
+		score.WrongAnswers,
+		score.UnansweredCount,
+		score.RawPoints,
+		score.PenaltyPoints,
+		score.MaxPoints,
+		score.Score,
+		score.Status,
+		score.PendingGrading,
+		score.Passed,
+		score.Grade,
+		score.ID,
+	).Scan(&score.CreatedAt, &score.UpdatedAt)
 
+	if err == sql.ErrNoRows {
+		return repository.ErrScoreNotFound
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to update score")
+	}
+
+	return tx.Commit()
+}
+
+func (r *postgresRepository) GetScore(ctx context.Context, id string) (*domain.ExamScore, error) {
+	query := `SELECT` + scoreColumns + `
+        FROM exam_scores
+        WHERE id = $1`
+
+	score, err := scanScore(r.db.QueryRowContext(ctx, query, id))
 	if err == sql.ErrNoRows {
 		return nil, repository.ErrScoreNotFound
 	}
@@ -87,29 +190,27 @@ func (r *postgresRepository) GetScore(ctx context.Context, id string) (*domain.E
 }
 
 func (r *postgresRepository) GetScoreByExamAndStudent(ctx context.Context, examID, studentID string) (*domain.ExamScore, error) {
-	score := &domain.ExamScore{}
-
-	query := `
-        SELECT id, exam_id, session_id, student_id, total_questions,
-               correct_answers, wrong_answers, unanswered, score,
-               created_at, updated_at
+	query := `SELECT` + scoreColumns + `
         FROM exam_scores
         WHERE exam_id = $1 AND student_id = $2`
 
-	err := r.db.QueryRowContext(ctx, query, examID, studentID).Scan(
-		&score.ID,
-		&score.ExamID,
-		&score.SessionID,
-		&score.StudentID,
-		&score.TotalQuestions,
-		&score.CorrectAnswers,
-		&score.WrongAnswers,
-		&score.UnansweredCount,
-		&score.Score,
-		&score.CreatedAt,
-		&score.UpdatedAt,
-	)
+	score, err := scanScore(r.db.QueryRowContext(ctx, query, examID, studentID))
+	if err == sql.ErrNoRows {
+		return nil, repository.ErrScoreNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get score")
+	}
 
+	return score, nil
+}
+
+func (r *postgresRepository) GetScoreBySession(ctx context.Context, sessionID string) (*domain.ExamScore, error) {
+	query := `SELECT` + scoreColumns + `
+        FROM exam_scores
+        WHERE session_id = $1`
+
+	score, err := scanScore(r.db.QueryRowContext(ctx, query, sessionID))
 	if err == sql.ErrNoRows {
 		return nil, repository.ErrScoreNotFound
 	}
@@ -120,17 +221,47 @@ func (r *postgresRepository) GetScoreByExamAndStudent(ctx context.Context, examI
 	return score, nil
 }
 
-func (r *postgresRepository) ListScores(ctx context.Context, examID string, limit int32, offset int32) ([]*domain.ExamScore, error) {
-	query := `
-        SELECT id, exam_id, session_id, student_id, total_questions,
-               correct_answers, wrong_answers, unanswered, score,
-               created_at, updated_at
+// ListExamScores mengembalikan semua nilai ujian tanpa paginasi
+func (r *postgresRepository) ListExamScores(ctx context.Context, examID string) ([]*domain.ExamScore, error) {
+	query := `SELECT` + scoreColumns + `
+        FROM exam_scores
+        WHERE exam_id = $1`
+
+	rows, err := r.db.QueryContext(ctx, query, examID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list exam scores")
+	}
+	defer rows.Close()
+
+	var scores []*domain.ExamScore
+	for rows.Next() {
+		score, err := scanScore(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan score")
+		}
+		scores = append(scores, score)
+	}
+
+	return scores, rows.Err()
+}
+
+// ListScores mengembalikan nilai tertinggi lebih dulu, mulai setelah cursor after
+// (Value berisi nilai, ID berisi id skor)
+func (r *postgresRepository) ListScores(ctx context.Context, filter domain.ScoreFilter, limit int32, after *pagination.Cursor) ([]*domain.ExamScore, error) {
+	query := `SELECT` + scoreColumns + `
         FROM exam_scores
         WHERE exam_id = $1
-        ORDER BY score DESC
-        LIMIT $2 OFFSET $3`
+          AND ($2::boolean IS NULL OR passed = $2)
+          AND ($3::numeric IS NULL OR (score, id) < ($3, $4::uuid))
+        ORDER BY score DESC, id DESC
+        LIMIT $5`
 
-	rows, err := r.db.QueryContext(ctx, query, examID, limit, offset)
+	var afterScore, afterID interface{}
+	if after != nil {
+		afterScore, afterID = after.Value, after.ID
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, filter.ExamID, filter.Passed, afterScore, afterID, limit)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to list scores")
 	}
@@ -138,38 +269,389 @@ func (r *postgresRepository) ListScores(ctx context.Context, examID string, limi
 
 	var scores []*domain.ExamScore
 	for rows.Next() {
-		score := &domain.ExamScore{}
-		err := rows.Scan(
-			&score.ID,
-			&score.ExamID,
-			&score.SessionID,
-			&score.StudentID,
-			&score.TotalQuestions,
-			&score.CorrectAnswers,
-			&score.WrongAnswers,
-			&score.UnansweredCount,
-			&score.Score,
-			&score.CreatedAt,
-			&score.UpdatedAt,
-		)
+		score, err := scanScore(rows)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to scan score")
 		}
 		scores = append(scores, score)
 	}
 
-	return scores, nil
+	return scores, rows.Err()
 }
 
-func (r *postgresRepository) GetCorrectAnswers(ctx context.Context, sessionID string) ([]domain.Answer, error) {
+func (r *postgresRepository) CountScores(ctx context.Context, filter domain.ScoreFilter) (int32, error) {
+	var count int32
+	err := r.db.QueryRowContext(ctx, `
+        SELECT COUNT(*) FROM exam_scores
+        WHERE exam_id = $1
+          AND ($2::boolean IS NULL OR passed = $2)`,
+		filter.ExamID, filter.Passed,
+	).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to count scores")
+	}
+	return count, nil
+}
+
+// ExportScores membaca seluruh nilai ujian baris demi baris dan memanggil fn
+// untuk setiap baris, tanpa memuat semuanya ke memori
+func (r *postgresRepository) ExportScores(ctx context.Context, examID string, fn func(*domain.ScoreExportRow) error) error {
+	rows, err := r.db.QueryContext(ctx, `SELECT`+scoreColumns+`,
+            COALESCE(ss.student_name, ''), COALESCE(ss.class_id::text, '')
+        FROM exam_scores
+        LEFT JOIN (
+            SELECT student_id AS roster_student_id, student_name, class_id
+            FROM exam_student_status
+            WHERE exam_id = $1
+        ) ss ON ss.roster_student_id = exam_scores.student_id
+        WHERE exam_id = $1
+        ORDER BY ss.class_id, ss.student_name, student_id`,
+		examID,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to export scores")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		row := &domain.ScoreExportRow{}
+		row.Score, err = scanScore(rows, &row.StudentName, &row.ClassID)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan score")
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+func (r *postgresRepository) ListScoreSamples(ctx context.Context, examID string) ([]domain.ScoreSample, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT s.student_id, COALESCE(ss.class_id::text, ''), s.score, s.max_points
+        FROM exam_scores s
+        LEFT JOIN exam_student_status ss ON ss.exam_id = s.exam_id AND ss.student_id = s.student_id
+        WHERE s.exam_id = $1`,
+		examID,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list score samples")
+	}
+	defer rows.Close()
+
+	var samples []domain.ScoreSample
+	for rows.Next() {
+		var sample domain.ScoreSample
+		if err := rows.Scan(&sample.StudentID, &sample.ClassID, &sample.Score, &sample.MaxPoints); err != nil {
+			return nil, errors.Wrap(err, "failed to scan score sample")
+		}
+		samples = append(samples, sample)
+	}
+
+	return samples, rows.Err()
+}
+
+func (r *postgresRepository) ListExamClasses(ctx context.Context, examID string) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT class_id FROM exam_classes WHERE exam_id = $1 ORDER BY class_id`,
+		examID,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list exam classes")
+	}
+	defer rows.Close()
+
+	var classIDs []string
+	for rows.Next() {
+		var classID string
+		if err := rows.Scan(&classID); err != nil {
+			return nil, errors.Wrap(err, "failed to scan exam class")
+		}
+		classIDs = append(classIDs, classID)
+	}
+
+	return classIDs, rows.Err()
+}
+
+// ListClosedSessions mengembalikan sesi ujian yang sudah selesai; jika questionID
+// diisi, hanya sesi yang soalnya memuat pertanyaan tersebut
+func (r *postgresRepository) ListClosedSessions(ctx context.Context, examID, questionID string) ([]string, error) {
 	query := `
-        SELECT q.id, q.correct_answer, sa.selected_choice
+        SELECT es.id
+        FROM exam_sessions es
+        WHERE es.exam_id = $1
+          AND es.status IN ('FINISHED', 'TIMEOUT')
+          AND ($2::text = '' OR EXISTS (
+              SELECT 1 FROM session_questions sq
+              WHERE sq.session_id = es.id AND sq.question_id::text = $2
+          ))
+        ORDER BY es.start_time`
+
+	rows, err := r.db.QueryContext(ctx, query, examID, questionID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list closed sessions")
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.Wrap(err, "failed to scan session")
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+func (r *postgresRepository) GetSessionInfo(ctx context.Context, sessionID string) (*domain.SessionInfo, error) {
+	info := &domain.SessionInfo{}
+
+	err := r.db.QueryRowContext(ctx,
+		"SELECT id, exam_id, student_id, status FROM exam_sessions WHERE id = $1",
+		sessionID,
+	).Scan(&info.SessionID, &info.ExamID, &info.StudentID, &info.Status)
+
+	if err == sql.ErrNoRows {
+		return nil, repository.ErrSessionNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get session")
+	}
+
+	return info, nil
+}
+
+// GetReportHeader mengisi identitas ujian dan siswa untuk rapor sebuah sesi
+func (r *postgresRepository) GetReportHeader(ctx context.Context, sessionID string) (*domain.SessionReport, error) {
+	report := &domain.SessionReport{}
+
+	err := r.db.QueryRowContext(ctx, `
+        SELECT es.id, es.exam_id, e.title, e.subject, es.student_id,
+               COALESCE(ss.student_name, ''), COALESCE(ss.class_id::text, '')
         FROM exam_sessions es
-        JOIN questions q ON q.exam_id = es.exam_id
-        LEFT JOIN session_answers sa ON sa.question_id = q.id AND sa.session_id = es.id
-        WHERE es.id = $1`
+        JOIN exams e ON e.id = es.exam_id
+        LEFT JOIN exam_student_status ss ON ss.exam_id = es.exam_id AND ss.student_id = es.student_id
+        WHERE es.id = $1`,
+		sessionID,
+	).Scan(
+		&report.SessionID,
+		&report.ExamID,
+		&report.ExamTitle,
+		&report.Subject,
+		&report.StudentID,
+		&report.StudentName,
+		&report.ClassID,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, repository.ErrSessionNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get report header")
+	}
+
+	return report, nil
+}
+
+func (r *postgresRepository) ListReportQuestions(ctx context.Context, sessionID string) ([]domain.ReportQuestion, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT sq.position, q.question_text,
+               ARRAY(SELECT c.text FROM choices c WHERE c.question_id = q.id ORDER BY c.position, c.id),`+answerColumns+`
+        FROM session_questions sq`+answerJoins+`
+        WHERE sq.session_id = $1
+        ORDER BY sq.position`,
+		sessionID,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list report questions")
+	}
+	defer rows.Close()
+
+	var questions []domain.ReportQuestion
+	for rows.Next() {
+		var q domain.ReportQuestion
+		q.Answer, err = scanAnswer(rows, &q.Number, &q.Text, pq.Array(&q.Choices))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan report question")
+		}
+		questions = append(questions, q)
+	}
+
+	return questions, rows.Err()
+}
+
+// SaveGradingScale menyimpan skala untuk ujian atau mata pelajaran, menimpa skala
+// sebelumnya untuk target yang sama
+func (r *postgresRepository) SaveGradingScale(ctx context.Context, scale *domain.GradingScale) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var query string
+	var target string
+	if scale.ExamID != "" {
+		target = scale.ExamID
+		query = `
+            INSERT INTO grading_scales (exam_id, pass_mark)
+            VALUES ($1, $2)
+            ON CONFLICT (exam_id) WHERE exam_id IS NOT NULL
+            DO UPDATE SET pass_mark = EXCLUDED.pass_mark, updated_at = CURRENT_TIMESTAMP
+            RETURNING id`
+	} else {
+		target = scale.Subject
+		query = `
+            INSERT INTO grading_scales (subject, pass_mark)
+            VALUES ($1, $2)
+            ON CONFLICT (subject) WHERE exam_id IS NULL
+            DO UPDATE SET pass_mark = EXCLUDED.pass_mark, updated_at = CURRENT_TIMESTAMP
+            RETURNING id`
+	}
+
+	if err := tx.QueryRowContext(ctx, query, target, scale.PassMark).Scan(&scale.ID); err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" { // foreign_key_violation
+			return repository.ErrExamNotFound
+		}
+		return errors.Wrap(err, "failed to save grading scale")
+	}
 
-	rows, err := r.db.QueryContext(ctx, query, sessionID)
+	if _, err := tx.ExecContext(ctx, "DELETE FROM grading_scale_bands WHERE scale_id = $1", scale.ID); err != nil {
+		return errors.Wrap(err, "failed to delete old grade bands")
+	}
+	for _, band := range scale.Bands {
+		_, err := tx.ExecContext(ctx,
+			"INSERT INTO grading_scale_bands (scale_id, grade, min_score) VALUES ($1, $2, $3)",
+			scale.ID, band.Grade, band.MinScore,
+		)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert grade band")
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetGradingScale mengembalikan skala yang berlaku untuk ujian: skala ujian itu
+// sendiri, atau skala mata pelajarannya jika tidak ada
+func (r *postgresRepository) GetGradingScale(ctx context.Context, examID string) (*domain.GradingScale, error) {
+	return r.getGradingScale(ctx, `
+        SELECT gs.id, COALESCE(gs.exam_id::text, ''), COALESCE(gs.subject, ''), gs.pass_mark
+        FROM exams e
+        JOIN grading_scales gs ON gs.exam_id = e.id OR (gs.exam_id IS NULL AND gs.subject = e.subject)
+        WHERE e.id = $1
+        ORDER BY gs.exam_id IS NULL
+        LIMIT 1`, examID)
+}
+
+func (r *postgresRepository) GetSubjectGradingScale(ctx context.Context, subject string) (*domain.GradingScale, error) {
+	return r.getGradingScale(ctx, `
+        SELECT id, '', subject, pass_mark
+        FROM grading_scales
+        WHERE exam_id IS NULL AND subject = $1`, subject)
+}
+
+// ListExamsUsingScale mengembalikan ujian yang nilainya mengikuti skala: ujian
+// skala itu sendiri, atau untuk skala mata pelajaran, ujian mata pelajaran
+// tersebut yang tidak memiliki skala sendiri
+func (r *postgresRepository) ListExamsUsingScale(ctx context.Context, scale *domain.GradingScale) ([]string, error) {
+	if scale.ExamID != "" {
+		return []string{scale.ExamID}, nil
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT e.id
+        FROM exams e
+        WHERE e.subject = $1
+          AND NOT EXISTS (SELECT 1 FROM grading_scales gs WHERE gs.exam_id = e.id)`,
+		scale.Subject,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list exams using grading scale")
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.Wrap(err, "failed to scan exam")
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+func (r *postgresRepository) getGradingScale(ctx context.Context, query string, arg string) (*domain.GradingScale, error) {
+	scale := &domain.GradingScale{}
+	err := r.db.QueryRowContext(ctx, query, arg).Scan(&scale.ID, &scale.ExamID, &scale.Subject, &scale.PassMark)
+	if err == sql.ErrNoRows {
+		return nil, repository.ErrGradingScaleNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get grading scale")
+	}
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT grade, min_score FROM grading_scale_bands WHERE scale_id = $1 ORDER BY min_score DESC",
+		scale.ID,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get grade bands")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var band domain.GradeBand
+		if err := rows.Scan(&band.Grade, &band.MinScore); err != nil {
+			return nil, errors.Wrap(err, "failed to scan grade band")
+		}
+		scale.Bands = append(scale.Bands, band)
+	}
+
+	return scale, rows.Err()
+}
+
+const (
+	answerColumns = `
+        q.id, q.type, q.correct_answer, q.partial_credit, q.points,
+        COALESCE(sa.selected_choice, ''), COALESCE(sa.answer_text, ''),
+        g.points IS NOT NULL, COALESCE(g.points, 0)`
+
+	answerJoins = `
+        JOIN questions q ON q.id = sq.question_id
+        LEFT JOIN session_answers sa ON sa.question_id = sq.question_id AND sa.session_id = sq.session_id
+        LEFT JOIN answer_grades g ON g.question_id = sq.question_id AND g.session_id = sq.session_id`
+
+	// answerQuery memilih soal sesi beserta jawaban siswa dan nilai manualnya, jika ada
+	answerQuery = `SELECT` + answerColumns + `
+        FROM session_questions sq` + answerJoins
+)
+
+// scanAnswer membaca kolom answerColumns; prefix dibaca lebih dulu jika query
+// memilih kolom tambahan di depannya
+func scanAnswer(row rowScanner, prefix ...interface{}) (domain.Answer, error) {
+	var answer domain.Answer
+	err := row.Scan(append(prefix,
+		&answer.QuestionID,
+		&answer.QuestionType,
+		&answer.CorrectAnswer,
+		&answer.PartialCredit,
+		&answer.Points,
+		&answer.StudentAnswer,
+		&answer.AnswerText,
+		&answer.Graded,
+		&answer.AwardedPoints,
+	)...)
+	return answer, err
+}
+
+func (r *postgresRepository) queryAnswers(ctx context.Context, query string, args ...interface{}) ([]domain.Answer, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get answers")
 	}
@@ -177,17 +659,15 @@ func (r *postgresRepository) GetCorrectAnswers(ctx context.Context, sessionID st
 
 	var answers []domain.Answer
 	for rows.Next() {
-		var answer domain.Answer
-		err := rows.Scan(
-			&answer.QuestionID,
-			&answer.CorrectAnswer,
-			&answer.StudentAnswer,
-		)
+		answer, err := scanAnswer(rows)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to scan answer")
 		}
 		answers = append(answers, answer)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to get answers")
+	}
 
 	if len(answers) == 0 {
 		return nil, repository.ErrSessionNotFound
@@ -196,36 +676,442 @@ func (r *postgresRepository) GetCorrectAnswers(ctx context.Context, sessionID st
 	return answers, nil
 }
 
+func (r *postgresRepository) ListExamItems(ctx context.Context, examID string) ([]domain.ExamItem, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT q.id, q.question_text, q.type, q.correct_answer, q.points, COUNT(c.id)
+         FROM questions q
+         LEFT JOIN choices c ON c.question_id = q.id
+         WHERE q.exam_id = $1
+         GROUP BY q.id
+         ORDER BY q.created_at, q.id`,
+		examID,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list exam items")
+	}
+	defer rows.Close()
+
+	var items []domain.ExamItem
+	for rows.Next() {
+		var item domain.ExamItem
+		if err := rows.Scan(
+			&item.QuestionID,
+			&item.QuestionText,
+			&item.QuestionType,
+			&item.CorrectAnswer,
+			&item.Points,
+			&item.ChoiceCount,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan exam item")
+		}
+		items = append(items, item)
+	}
+
+	return items, rows.Err()
+}
+
+func (r *postgresRepository) ListExamAnswers(ctx context.Context, examID string) (map[string][]domain.Answer, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT sq.session_id,`+answerColumns+`
+        FROM exam_sessions es
+        JOIN session_questions sq ON sq.session_id = es.id`+answerJoins+`
+        WHERE es.exam_id = $1 AND es.status IN ('FINISHED', 'TIMEOUT')
+        ORDER BY sq.session_id, sq.position`,
+		examID,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list exam answers")
+	}
+	defer rows.Close()
+
+	sessions := make(map[string][]domain.Answer)
+	for rows.Next() {
+		var sessionID string
+		answer, err := scanAnswer(rows, &sessionID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan answer")
+		}
+		sessions[sessionID] = append(sessions[sessionID], answer)
+	}
+
+	return sessions, rows.Err()
+}
+
+func (r *postgresRepository) GetScoringPolicy(ctx context.Context, examID string) (*domain.ScoringPolicy, error) {
+	policy := &domain.ScoringPolicy{}
+	err := r.db.QueryRowContext(ctx,
+		`SELECT score_policy, score_max, penalty_type, penalty_value, allow_negative_score
+         FROM exams WHERE id = $1`,
+		examID,
+	).Scan(
+		&policy.Policy,
+		&policy.ScoreMax,
+		&policy.Penalty.Type,
+		&policy.Penalty.Value,
+		&policy.Penalty.AllowNegative,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, repository.ErrExamNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get scoring policy")
+	}
+
+	return policy, nil
+}
+
+func (r *postgresRepository) GetCorrectAnswers(ctx context.Context, sessionID string) ([]domain.Answer, error) {
+	return r.queryAnswers(ctx, answerQuery+`
+        WHERE sq.session_id = $1
+        ORDER BY sq.position`, sessionID)
+}
+
 func (r *postgresRepository) GetStudentAnswers(ctx context.Context, sessionID string) ([]domain.Answer, error) {
-	query := `
-        SELECT sa.question_id, q.correct_answer, sa.selected_choice
+	return r.queryAnswers(ctx, answerQuery+`
+        WHERE sq.session_id = $1 AND sa.id IS NOT NULL
+        ORDER BY sq.position`, sessionID)
+}
+
+func (r *postgresRepository) GetAnswer(ctx context.Context, sessionID, questionID string) (*domain.Answer, error) {
+	answer, err := scanAnswer(r.db.QueryRowContext(ctx, answerQuery+`
+        WHERE sq.session_id = $1 AND sq.question_id = $2`, sessionID, questionID))
+	if err == sql.ErrNoRows {
+		return nil, repository.ErrQuestionNotInSession
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get answer")
+	}
+
+	return &answer, nil
+}
+
+// pendingGradingFrom memilih jawaban teks bebas dari sesi yang sudah selesai
+// dan belum dinilai guru untuk ujian $1
+const pendingGradingFrom = `
         FROM session_answers sa
+        JOIN exam_sessions es ON es.id = sa.session_id
         JOIN questions q ON q.id = sa.question_id
-        WHERE sa.session_id = $1`
+        LEFT JOIN answer_grades g ON g.session_id = sa.session_id AND g.question_id = sa.question_id
+        WHERE es.exam_id = $1
+          AND es.status IN ('FINISHED', 'TIMEOUT')
+          AND q.type IN ('ESSAY', 'SHORT_ANSWER')
+          AND sa.answer_text <> ''
+          AND g.id IS NULL`
+
+// ListPendingGrading mengembalikan jawaban terlama lebih dulu, mulai setelah cursor after
+func (r *postgresRepository) ListPendingGrading(ctx context.Context, examID string, limit int32, after *pagination.Cursor) ([]*domain.PendingAnswer, error) {
+	query := `
+        SELECT sa.id, es.id, es.exam_id, es.student_id, q.id, q.type, q.question_text,
+               sa.answer_text, sa.answered_at` + pendingGradingFrom + `
+          AND ($2::timestamptz IS NULL OR (sa.answered_at, sa.id) > ($2, $3::uuid))
+        ORDER BY sa.answered_at, sa.id
+        LIMIT $4`
 
-	rows, err := r.db.QueryContext(ctx, query, sessionID)
+	var afterTime, afterID interface{}
+	if after != nil {
+		afterTime, afterID = after.Time, after.ID
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, examID, afterTime, afterID, limit)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get student answers")
+		return nil, errors.Wrap(err, "failed to list pending grading")
 	}
 	defer rows.Close()
 
-	var answers []domain.Answer
+	var pending []*domain.PendingAnswer
 	for rows.Next() {
-		var answer domain.Answer
+		p := &domain.PendingAnswer{}
 		err := rows.Scan(
-			&answer.QuestionID,
-			&answer.CorrectAnswer,
-			&answer.StudentAnswer,
+			&p.AnswerID,
+			&p.SessionID,
+			&p.ExamID,
+			&p.StudentID,
+			&p.QuestionID,
+			&p.QuestionType,
+			&p.QuestionText,
+			&p.AnswerText,
+			&p.AnsweredAt,
 		)
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to scan answer")
+			return nil, errors.Wrap(err, "failed to scan pending answer")
 		}
-		answers = append(answers, answer)
+		pending = append(pending, p)
 	}
 
-	if len(answers) == 0 {
-		return nil, repository.ErrSessionNotFound
+	return pending, rows.Err()
+}
+
+func (r *postgresRepository) CountPendingGrading(ctx context.Context, examID string) (int32, error) {
+	var count int32
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*)`+pendingGradingFrom, examID).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to count pending grading")
 	}
+	return count, nil
+}
 
-	return answers, nil
+// SaveGrade menyimpan nilai manual sebuah jawaban; penilaian ulang menimpa nilai sebelumnya
+func (r *postgresRepository) SaveGrade(ctx context.Context, grade *domain.AnswerGrade) error {
+	query := `
+        INSERT INTO answer_grades (session_id, question_id, points, comment)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (session_id, question_id)
+        DO UPDATE SET points = EXCLUDED.points,
+                      comment = EXCLUDED.comment,
+                      graded_at = CURRENT_TIMESTAMP
+        RETURNING id, graded_at`
+
+	err := r.db.QueryRowContext(ctx, query,
+		grade.SessionID,
+		grade.QuestionID,
+		grade.Points,
+		grade.Comment,
+	).Scan(&grade.ID, &grade.GradedAt)
+	if err != nil {
+		return errors.Wrap(err, "failed to save grade")
+	}
+
+	return nil
+}
+
+// SaveCollusionResult mencatat analisis dan mengganti tanda yang belum ditinjau.
+// Tanda yang sudah ditinjau tetap disimpan beserta hasil tinjauannya.
+func (r *postgresRepository) SaveCollusionResult(ctx context.Context, result *domain.CollusionResult) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+        INSERT INTO collusion_runs (exam_id, sessions, pairs_compared, analyzed_at)
+        VALUES ($1, $2, $3, CURRENT_TIMESTAMP)
+        ON CONFLICT (exam_id) DO UPDATE SET
+            sessions = EXCLUDED.sessions,
+            pairs_compared = EXCLUDED.pairs_compared,
+            analyzed_at = EXCLUDED.analyzed_at`,
+		result.ExamID, result.Sessions, result.PairsCompared,
+	)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" { // foreign_key_violation
+			return repository.ErrExamNotFound
+		}
+		return errors.Wrap(err, "failed to save collusion run")
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`DELETE FROM collusion_flags WHERE exam_id = $1 AND status = 'PENDING'`,
+		result.ExamID,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to clear collusion flags")
+	}
+
+	for _, flag := range result.Flags {
+		_, err = tx.ExecContext(ctx, `
+            INSERT INTO collusion_flags (
+                exam_id, session_a, session_b, both_wrong, shared_wrong, expected, probability
+            ) VALUES ($1, $2, $3, $4, $5, $6, $7)
+            ON CONFLICT (exam_id, session_a, session_b) DO UPDATE SET
+                both_wrong = EXCLUDED.both_wrong,
+                shared_wrong = EXCLUDED.shared_wrong,
+                expected = EXCLUDED.expected,
+                probability = EXCLUDED.probability,
+                updated_at = CURRENT_TIMESTAMP`,
+			result.ExamID,
+			flag.SessionA,
+			flag.SessionB,
+			flag.BothWrong,
+			flag.SharedWrong,
+			flag.Expected,
+			flag.Probability,
+		)
+		if err != nil {
+			return errors.Wrap(err, "failed to save collusion flag")
+		}
+	}
+
+	return tx.Commit()
+}
+
+const collusionFlagQuery = `
+        SELECT f.id, f.exam_id, f.session_a, f.session_b, sa.student_id, sb.student_id,
+               f.both_wrong, f.shared_wrong, f.expected, f.probability, f.status,
+               f.review_note, f.reviewed_by, f.reviewed_at, f.created_at
+        FROM collusion_flags f
+        JOIN exam_sessions sa ON sa.id = f.session_a
+        JOIN exam_sessions sb ON sb.id = f.session_b`
+
+func scanCollusionFlag(row rowScanner) (*domain.CollusionFlag, error) {
+	flag := &domain.CollusionFlag{}
+	var reviewedAt sql.NullTime
+	err := row.Scan(
+		&flag.ID,
+		&flag.ExamID,
+		&flag.SessionA,
+		&flag.SessionB,
+		&flag.StudentA,
+		&flag.StudentB,
+		&flag.BothWrong,
+		&flag.SharedWrong,
+		&flag.Expected,
+		&flag.Probability,
+		&flag.Status,
+		&flag.ReviewNote,
+		&flag.ReviewedBy,
+		&reviewedAt,
+		&flag.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if reviewedAt.Valid {
+		flag.ReviewedAt = &reviewedAt.Time
+	}
+	return flag, nil
+}
+
+// ListCollusionFlags mengembalikan tanda untuk ujian, yang paling mencurigakan
+// lebih dulu; status kosong berarti semua status
+func (r *postgresRepository) ListCollusionFlags(ctx context.Context, examID string, status domain.CollusionStatus) ([]*domain.CollusionFlag, error) {
+	rows, err := r.db.QueryContext(ctx, collusionFlagQuery+`
+        WHERE f.exam_id = $1
+          AND ($2::text = '' OR f.status::text = $2)
+        ORDER BY f.probability, f.shared_wrong DESC`,
+		examID, string(status),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list collusion flags")
+	}
+	defer rows.Close()
+
+	var flags []*domain.CollusionFlag
+	for rows.Next() {
+		flag, err := scanCollusionFlag(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan collusion flag")
+		}
+		flags = append(flags, flag)
+	}
+
+	return flags, rows.Err()
+}
+
+func (r *postgresRepository) ReviewCollusionFlag(ctx context.Context, id string, status domain.CollusionStatus, note, reviewedBy string) (*domain.CollusionFlag, error) {
+	result, err := r.db.ExecContext(ctx, `
+        UPDATE collusion_flags
+        SET status = $2, review_note = $3, reviewed_by = $4,
+            reviewed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+        WHERE id = $1`,
+		id, status, note, reviewedBy,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to review collusion flag")
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get affected rows")
+	}
+	if rows == 0 {
+		return nil, repository.ErrCollusionFlagNotFound
+	}
+
+	flag, err := scanCollusionFlag(r.db.QueryRowContext(ctx, collusionFlagQuery+`
+        WHERE f.id = $1`, id))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get collusion flag")
+	}
+
+	return flag, nil
+}
+
+// ListExamsForCollusion mengembalikan ujian selesai yang belum dianalisis, atau
+// yang nilainya berubah setelah analisis terakhir (misalnya karena regrade)
+func (r *postgresRepository) ListExamsForCollusion(ctx context.Context, limit int32) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT e.id
+        FROM exams e
+        LEFT JOIN collusion_runs cr ON cr.exam_id = e.id
+        WHERE e.status = 'FINISHED'
+          AND (cr.exam_id IS NULL
+               OR cr.analyzed_at < (SELECT MAX(s.updated_at) FROM exam_scores s WHERE s.exam_id = e.id))
+        ORDER BY e.end_time
+        LIMIT $1`,
+		limit,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list exams for collusion analysis")
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.Wrap(err, "failed to scan exam")
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// ClaimSessionEvents mengambil event yang siap diproses dan menundanya selama lease,
+// sehingga consumer lain tidak memproses event yang sama. Jika consumer mati sebelum
+// menyelesaikan event, event tersedia kembali setelah lease habis.
+func (r *postgresRepository) ClaimSessionEvents(ctx context.Context, limit int32, lease time.Duration) ([]domain.SessionEvent, error) {
+	query := `
+        UPDATE session_events
+        SET attempts = attempts + 1,
+            available_at = CURRENT_TIMESTAMP + make_interval(secs => $2)
+        WHERE id IN (
+            SELECT id FROM session_events
+            WHERE processed_at IS NULL AND available_at <= CURRENT_TIMESTAMP
+            ORDER BY available_at
+            LIMIT $1
+            FOR UPDATE SKIP LOCKED
+        )
+        RETURNING id, session_id, event_type, attempts`
+
+	rows, err := r.db.QueryContext(ctx, query, limit, lease.Seconds())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to claim session events")
+	}
+	defer rows.Close()
+
+	var events []domain.SessionEvent
+	for rows.Next() {
+		var event domain.SessionEvent
+		if err := rows.Scan(&event.ID, &event.SessionID, &event.Type, &event.Attempts); err != nil {
+			return nil, errors.Wrap(err, "failed to scan session event")
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+func (r *postgresRepository) CompleteSessionEvent(ctx context.Context, id string) error {
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE session_events SET processed_at = CURRENT_TIMESTAMP, last_error = NULL WHERE id = $1",
+		id,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to complete session event")
+	}
+	return nil
+}
+
+func (r *postgresRepository) FailSessionEvent(ctx context.Context, id string, cause error, retryAt time.Time) error {
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE session_events SET last_error = $1, available_at = $2 WHERE id = $3",
+		cause.Error(),
+		retryAt,
+		id,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to record session event failure")
+	}
+	return nil
 }
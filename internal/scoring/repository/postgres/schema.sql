@@ -1,3 +1,5 @@
+CREATE TYPE score_status AS ENUM ('PROVISIONAL', 'FINAL');
+
 CREATE TABLE exam_scores (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     exam_id UUID NOT NULL REFERENCES exams(id) ON DELETE CASCADE,
@@ -7,14 +9,115 @@ CREATE TABLE exam_scores (
     correct_answers INTEGER NOT NULL,
     wrong_answers INTEGER NOT NULL,
     unanswered INTEGER NOT NULL,
-    score DECIMAL(5,2) NOT NULL,
+    -- Poin mentah (jumlah bobot soal); score adalah nilai akhir sesuai score_policy ujian
+    raw_points DECIMAL(8,2) NOT NULL DEFAULT 0,
+    -- Total poin yang dikurangi karena jawaban salah (sudah termasuk dalam raw_points)
+    penalty_points DECIMAL(8,2) NOT NULL DEFAULT 0,
+    max_points DECIMAL(8,2) NOT NULL DEFAULT 0,
+    score DECIMAL(8,2) NOT NULL,
+    -- PROVISIONAL selama masih ada jawaban teks bebas yang belum dinilai
+    status score_status NOT NULL DEFAULT 'FINAL',
+    pending_grading INTEGER NOT NULL DEFAULT 0,
+    -- NULL jika ujian tidak memiliki skala penilaian
+    passed BOOLEAN,
+    grade VARCHAR(10) NOT NULL DEFAULT '',
     created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
     UNIQUE (exam_id, student_id)
 );
 
+-- Nilai manual guru untuk jawaban ESSAY dan SHORT_ANSWER
+CREATE TABLE answer_grades (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    session_id UUID NOT NULL REFERENCES exam_sessions(id) ON DELETE CASCADE,
+    question_id UUID NOT NULL REFERENCES questions(id) ON DELETE CASCADE,
+    points DECIMAL(6,2) NOT NULL,
+    comment TEXT NOT NULL DEFAULT '',
+    graded_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (session_id, question_id)
+);
+
+-- Salinan nilai lama setiap kali exam_scores ditimpa (hitung ulang, nilai manual, regrade, perubahan skala)
+CREATE TABLE score_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    score_id UUID NOT NULL REFERENCES exam_scores(id) ON DELETE CASCADE,
+    exam_id UUID NOT NULL REFERENCES exams(id) ON DELETE CASCADE,
+    session_id UUID NOT NULL,
+    student_id UUID NOT NULL,
+    total_questions INTEGER NOT NULL,
+    correct_answers INTEGER NOT NULL,
+    wrong_answers INTEGER NOT NULL,
+    unanswered INTEGER NOT NULL,
+    raw_points DECIMAL(8,2) NOT NULL,
+    penalty_points DECIMAL(8,2) NOT NULL,
+    max_points DECIMAL(8,2) NOT NULL,
+    score DECIMAL(8,2) NOT NULL,
+    status score_status NOT NULL,
+    passed BOOLEAN,
+    grade VARCHAR(10) NOT NULL DEFAULT '',
+    reason VARCHAR(32) NOT NULL,
+    recorded_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- KKM dan predikat nilai, untuk satu ujian atau satu mata pelajaran
+CREATE TABLE grading_scales (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    exam_id UUID REFERENCES exams(id) ON DELETE CASCADE,
+    subject VARCHAR(100),
+    pass_mark DECIMAL(8,2) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((exam_id IS NULL) <> (subject IS NULL))
+);
+
+CREATE TABLE grading_scale_bands (
+    scale_id UUID NOT NULL REFERENCES grading_scales(id) ON DELETE CASCADE,
+    grade VARCHAR(10) NOT NULL,
+    min_score DECIMAL(8,2) NOT NULL,
+    PRIMARY KEY (scale_id, grade)
+);
+
+CREATE TYPE collusion_status AS ENUM ('PENDING', 'CONFIRMED', 'DISMISSED');
+
+-- Analisis kecurangan terakhir per ujian
+CREATE TABLE collusion_runs (
+    exam_id UUID PRIMARY KEY REFERENCES exams(id) ON DELETE CASCADE,
+    sessions INTEGER NOT NULL,
+    pairs_compared INTEGER NOT NULL,
+    analyzed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Pasangan sesi dengan terlalu banyak jawaban salah yang identik, menunggu tinjauan guru
+CREATE TABLE collusion_flags (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    exam_id UUID NOT NULL REFERENCES exams(id) ON DELETE CASCADE,
+    session_a UUID NOT NULL REFERENCES exam_sessions(id) ON DELETE CASCADE,
+    session_b UUID NOT NULL REFERENCES exam_sessions(id) ON DELETE CASCADE,
+    both_wrong INTEGER NOT NULL,
+    shared_wrong INTEGER NOT NULL,
+    expected DOUBLE PRECISION NOT NULL,
+    probability DOUBLE PRECISION NOT NULL,
+    status collusion_status NOT NULL DEFAULT 'PENDING',
+    review_note TEXT NOT NULL DEFAULT '',
+    reviewed_by VARCHAR(255) NOT NULL DEFAULT '',
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (exam_id, session_a, session_b),
+    CHECK (session_a < session_b)
+);
+
 -- Indeks untuk mempercepat query
-CREATE INDEX idx_score_exam ON exam_scores(exam_id);
+CREATE INDEX idx_score_exam ON exam_scores(exam_id, score DESC, id DESC);
 CREATE INDEX idx_score_student ON exam_scores(student_id);
 CREATE INDEX idx_score_session ON exam_scores(session_id);
-CREATE INDEX idx_score_created ON exam_scores(created_at);
\ No newline at end of file
+CREATE INDEX idx_score_created ON exam_scores(created_at);
+
+CREATE INDEX idx_score_history_score ON score_history(score_id);
+CREATE INDEX idx_score_history_exam ON score_history(exam_id);
+
+CREATE UNIQUE INDEX idx_grading_scale_exam ON grading_scales(exam_id) WHERE exam_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_grading_scale_subject ON grading_scales(subject) WHERE exam_id IS NULL;
+CREATE INDEX idx_score_passed ON exam_scores(exam_id, passed);
+
+CREATE INDEX idx_collusion_flag_exam ON collusion_flags(exam_id, status);
\ No newline at end of file
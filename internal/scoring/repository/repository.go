@@ -3,26 +3,77 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/ApesJs/cbt-exam/internal/scoring/domain"
+	"github.com/ApesJs/cbt-exam/pkg/pagination"
 )
 
 type ScoringRepository interface {
 	// Score operations
 	CreateScore(ctx context.Context, score *domain.ExamScore) error
+	UpdateScore(ctx context.Context, score *domain.ExamScore, reason domain.ScoreChangeReason) error
 	GetScore(ctx context.Context, id string) (*domain.ExamScore, error)
 	GetScoreByExamAndStudent(ctx context.Context, examID, studentID string) (*domain.ExamScore, error)
-	ListScores(ctx context.Context, examID string, limit int32, offset int32) ([]*domain.ExamScore, error)
+	GetScoreBySession(ctx context.Context, sessionID string) (*domain.ExamScore, error)
+	ListExamScores(ctx context.Context, examID string) ([]*domain.ExamScore, error)
+	ListScores(ctx context.Context, filter domain.ScoreFilter, limit int32, after *pagination.Cursor) ([]*domain.ExamScore, error)
+	CountScores(ctx context.Context, filter domain.ScoreFilter) (int32, error)
+	ExportScores(ctx context.Context, examID string, fn func(*domain.ScoreExportRow) error) error
+
+	// Grading scales
+	SaveGradingScale(ctx context.Context, scale *domain.GradingScale) error
+	GetGradingScale(ctx context.Context, examID string) (*domain.GradingScale, error)
+	GetSubjectGradingScale(ctx context.Context, subject string) (*domain.GradingScale, error)
+	ListExamsUsingScale(ctx context.Context, scale *domain.GradingScale) ([]string, error)
+
+	// Session and exam lookup
+	GetSessionInfo(ctx context.Context, sessionID string) (*domain.SessionInfo, error)
+	ListClosedSessions(ctx context.Context, examID, questionID string) ([]string, error)
+	GetScoringPolicy(ctx context.Context, examID string) (*domain.ScoringPolicy, error)
 
 	// Answer validation
 	GetCorrectAnswers(ctx context.Context, sessionID string) ([]domain.Answer, error)
 	GetStudentAnswers(ctx context.Context, sessionID string) ([]domain.Answer, error)
+	GetAnswer(ctx context.Context, sessionID, questionID string) (*domain.Answer, error)
+
+	// Item analysis
+	ListExamItems(ctx context.Context, examID string) ([]domain.ExamItem, error)
+	ListExamAnswers(ctx context.Context, examID string) (map[string][]domain.Answer, error)
+
+	// Result reports
+	GetReportHeader(ctx context.Context, sessionID string) (*domain.SessionReport, error)
+	ListReportQuestions(ctx context.Context, sessionID string) ([]domain.ReportQuestion, error)
+
+	// Exam statistics
+	ListScoreSamples(ctx context.Context, examID string) ([]domain.ScoreSample, error)
+	ListExamClasses(ctx context.Context, examID string) ([]string, error)
+
+	// Manual grading
+	ListPendingGrading(ctx context.Context, examID string, limit int32, after *pagination.Cursor) ([]*domain.PendingAnswer, error)
+	CountPendingGrading(ctx context.Context, examID string) (int32, error)
+	SaveGrade(ctx context.Context, grade *domain.AnswerGrade) error
+
+	// Collusion detection
+	SaveCollusionResult(ctx context.Context, result *domain.CollusionResult) error
+	ListCollusionFlags(ctx context.Context, examID string, status domain.CollusionStatus) ([]*domain.CollusionFlag, error)
+	ReviewCollusionFlag(ctx context.Context, id string, status domain.CollusionStatus, note, reviewedBy string) (*domain.CollusionFlag, error)
+	ListExamsForCollusion(ctx context.Context, limit int32) ([]string, error)
+
+	// Session event consumption
+	ClaimSessionEvents(ctx context.Context, limit int32, lease time.Duration) ([]domain.SessionEvent, error)
+	CompleteSessionEvent(ctx context.Context, id string) error
+	FailSessionEvent(ctx context.Context, id string, cause error, retryAt time.Time) error
 }
 
 // Errors
 var (
-	ErrScoreNotFound   = errors.New("score not found")
-	ErrSessionNotFound = errors.New("session not found")
-	ErrExamNotFound    = errors.New("exam not found")
-	ErrDuplicateScore  = errors.New("score already exists for this exam and student")
+	ErrScoreNotFound         = errors.New("score not found")
+	ErrSessionNotFound       = errors.New("session not found")
+	ErrExamNotFound          = errors.New("exam not found")
+	ErrDuplicateScore        = errors.New("score already exists for this exam and student")
+	ErrSessionNotClosed      = errors.New("session is still in progress")
+	ErrQuestionNotInSession  = errors.New("question is not part of this session")
+	ErrGradingScaleNotFound  = errors.New("grading scale not found")
+	ErrCollusionFlagNotFound = errors.New("collusion flag not found")
 )
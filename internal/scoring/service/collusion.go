@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/ApesJs/cbt-exam/internal/scoring/repository"
+)
+
+// collusionBatchSize membatasi jumlah ujian yang dianalisis dalam satu putaran
+const collusionBatchSize = 10
+
+// CollusionJob menganalisis kemiripan jawaban setiap ujian yang sudah selesai
+// dan menyimpan pasangan yang mencurigakan untuk ditinjau guru. Ujian dianalisis
+// ulang jika nilainya berubah setelah analisis terakhir.
+type CollusionJob struct {
+	repo     repository.ScoringRepository
+	analyzer *scoringService
+	interval time.Duration
+}
+
+func NewCollusionJob(repo repository.ScoringRepository, interval time.Duration) *CollusionJob {
+	return &CollusionJob{
+		repo:     repo,
+		analyzer: &scoringService{repo: repo},
+		interval: interval,
+	}
+}
+
+// Run menjalankan analisis secara berkala sampai ctx dibatalkan
+func (j *CollusionJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.analyze(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *CollusionJob) analyze(ctx context.Context) {
+	examIDs, err := j.repo.ListExamsForCollusion(ctx, collusionBatchSize)
+	if err != nil {
+		log.Printf("collusion: failed to list exams: %v", err)
+		return
+	}
+
+	for _, examID := range examIDs {
+		result, err := j.analyzer.analyzeCollusion(ctx, examID)
+		if err != nil {
+			log.Printf("collusion: failed to analyze exam %s: %v", examID, err)
+			continue
+		}
+		if len(result.Flags) > 0 {
+			log.Printf("collusion: exam %s has %d flagged pairs", examID, len(result.Flags))
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ApesJs/cbt-exam/internal/scoring/domain"
+	"github.com/ApesJs/cbt-exam/internal/scoring/repository"
+)
+
+const (
+	// consumerBatchSize membatasi jumlah event yang diambil dalam satu putaran
+	consumerBatchSize = 50
+	// consumerLease adalah lama event disembunyikan dari consumer lain selama diproses
+	consumerLease = 2 * time.Minute
+	// consumerMaxBackoff membatasi jeda percobaan ulang event yang gagal
+	consumerMaxBackoff = 10 * time.Minute
+)
+
+// EventConsumer menilai sesi secara otomatis dari event penutupan sesi yang
+// dicatat SessionService. Event tetap tersimpan sampai berhasil diproses, jadi
+// sesi yang ditutup ketika scoring service mati akan dinilai setelah service hidup.
+// Setiap sesi dinilai tepat sekali karena skor yang sudah ada dianggap selesai.
+type EventConsumer struct {
+	repo     repository.ScoringRepository
+	scorer   *scoringService
+	interval time.Duration
+}
+
+func NewEventConsumer(repo repository.ScoringRepository, interval time.Duration) *EventConsumer {
+	return &EventConsumer{
+		repo:     repo,
+		scorer:   &scoringService{repo: repo},
+		interval: interval,
+	}
+}
+
+// Run memproses event secara berkala sampai ctx dibatalkan
+func (c *EventConsumer) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.consume(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *EventConsumer) consume(ctx context.Context) {
+	events, err := c.repo.ClaimSessionEvents(ctx, consumerBatchSize, consumerLease)
+	if err != nil {
+		log.Printf("consumer: failed to claim session events: %v", err)
+		return
+	}
+
+	for _, event := range events {
+		if err := c.handle(ctx, event); err != nil {
+			retryAt := time.Now().Add(backoff(event.Attempts))
+			log.Printf("consumer: failed to score session %s (attempt %d), retrying at %s: %v",
+				event.SessionID, event.Attempts, retryAt.Format(time.RFC3339), err)
+
+			if err := c.repo.FailSessionEvent(ctx, event.ID, err, retryAt); err != nil {
+				log.Printf("consumer: %v", err)
+			}
+			continue
+		}
+
+		if err := c.repo.CompleteSessionEvent(ctx, event.ID); err != nil {
+			log.Printf("consumer: %v", err)
+		}
+	}
+}
+
+func (c *EventConsumer) handle(ctx context.Context, event domain.SessionEvent) error {
+	_, err := c.scorer.calculate(ctx, event.SessionID, "")
+	if errors.Is(err, repository.ErrDuplicateScore) {
+		// Sesi sudah dinilai, misalnya oleh percobaan sebelumnya
+		return nil
+	}
+	return err
+}
+
+// backoff menggandakan jeda untuk setiap percobaan, mulai dari 5 detik
+func backoff(attempts int32) time.Duration {
+	delay := 5 * time.Second
+	for i := int32(1); i < attempts && delay < consumerMaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > consumerMaxBackoff {
+		delay = consumerMaxBackoff
+	}
+	return delay
+}
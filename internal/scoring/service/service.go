@@ -1,7 +1,10 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"sort"
+	"strconv"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
@@ -10,7 +13,9 @@ import (
 
 	scoringv1 "github.com/ApesJs/cbt-exam/api/proto/scoring/v1"
 	"github.com/ApesJs/cbt-exam/internal/scoring/domain"
+	"github.com/ApesJs/cbt-exam/internal/scoring/report"
 	"github.com/ApesJs/cbt-exam/internal/scoring/repository"
+	"github.com/ApesJs/cbt-exam/pkg/pagination"
 )
 
 type scoringService struct {
@@ -25,42 +30,112 @@ func NewScoringService(repo repository.ScoringRepository) scoringv1.ScoringServi
 }
 
 func (s *scoringService) CalculateScore(ctx context.Context, req *scoringv1.CalculateScoreRequest) (*scoringv1.ExamScore, error) {
-	// Get all answers from the session (both correct answers and student answers)
-	answers, err := s.repo.GetCorrectAnswers(ctx, req.SessionId)
+	var reason domain.ScoreChangeReason
+	if req.Recalculate {
+		reason = domain.ScoreChangeRecalculate
+	}
+
+	score, err := s.calculate(ctx, req.SessionId, reason)
 	if err != nil {
-		if errors.Is(err, repository.ErrSessionNotFound) {
+		switch {
+		case errors.Is(err, repository.ErrSessionNotFound):
 			return nil, status.Error(codes.NotFound, "session not found")
+		case errors.Is(err, repository.ErrSessionNotClosed):
+			return nil, status.Error(codes.FailedPrecondition, "session is still in progress")
+		case errors.Is(err, repository.ErrExamNotFound):
+			return nil, status.Error(codes.NotFound, "exam not found")
+		case errors.Is(err, repository.ErrDuplicateScore):
+			return nil, status.Error(codes.AlreadyExists, "score already exists for this exam and student")
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to calculate score: %v", err)
+		}
+	}
+
+	return convertDomainToProto(score), nil
+}
+
+// calculate menilai sesi dan menyimpan hasilnya. Dipakai oleh RPC CalculateScore
+// dan oleh EventConsumer saat sesi ditutup. Jika reason diisi, nilai yang sudah ada
+// untuk ujian dan siswa tersebut ditimpa dan nilai lamanya dicatat di riwayat;
+// jika kosong, nilai yang sudah ada menghasilkan ErrDuplicateScore.
+func (s *scoringService) calculate(ctx context.Context, sessionID string, reason domain.ScoreChangeReason) (*domain.ExamScore, error) {
+	score, err := s.grade(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save the score
+	if reason != "" {
+		existing, err := s.repo.GetScoreByExamAndStudent(ctx, score.ExamID, score.StudentID)
+		switch {
+		case err == nil:
+			if err := s.save(ctx, score, existing, reason); err != nil {
+				return nil, err
+			}
+			return score, nil
+		case !errors.Is(err, repository.ErrScoreNotFound):
+			return nil, err
 		}
-		return nil, status.Errorf(codes.Internal, "failed to get answers: %v", err)
+	}
+
+	if err := s.repo.CreateScore(ctx, score); err != nil {
+		return nil, err
+	}
+
+	return score, nil
+}
+
+// grade menilai sesi yang sudah ditutup tanpa menyimpan hasilnya
+func (s *scoringService) grade(ctx context.Context, sessionID string) (*domain.ExamScore, error) {
+	session, err := s.repo.GetSessionInfo(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if !session.IsClosed() {
+		return nil, repository.ErrSessionNotClosed
+	}
+
+	// Get all answers from the session (both correct answers and student answers)
+	answers, err := s.repo.GetCorrectAnswers(ctx, sessionID)
+	if err != nil {
+		return nil, err
 	}
 
 	// Calculate score
 	score := &domain.ExamScore{
-		SessionID:      req.SessionId,
-		TotalQuestions: int32(len(answers)),
+		ExamID:    session.ExamID,
+		SessionID: sessionID,
+		StudentID: session.StudentID,
 	}
 
-	// Count correct answers and unanswered questions
-	for _, answer := range answers {
-		if answer.StudentAnswer == "" {
-			score.UnansweredCount++
-		} else if answer.StudentAnswer == answer.CorrectAnswer {
-			score.CorrectAnswers++
-		}
+	policy, err := s.repo.GetScoringPolicy(ctx, session.ExamID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Calculate score percentage
-	score.CalculateScore()
+	scale, err := s.repo.GetGradingScale(ctx, session.ExamID)
+	if err != nil && !errors.Is(err, repository.ErrGradingScaleNotFound) {
+		return nil, err
+	}
 
-	// Save the score
-	if err := s.repo.CreateScore(ctx, score); err != nil {
-		if errors.Is(err, repository.ErrDuplicateScore) {
-			return nil, status.Error(codes.AlreadyExists, "score already exists for this exam and student")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to save score: %v", err)
+	// Count correct answers and raw points after penalties, then apply the exam's
+	// score policy and grading scale
+	score.Tally(answers, policy.Penalty)
+	score.CalculateScore(*policy)
+	score.ApplyScale(scale)
+
+	return score, nil
+}
+
+// save menyimpan nilai baru, atau menimpa existing dan mencatat nilai lamanya
+// di riwayat dengan alasan reason
+func (s *scoringService) save(ctx context.Context, score, existing *domain.ExamScore, reason domain.ScoreChangeReason) error {
+	if existing == nil {
+		return s.repo.CreateScore(ctx, score)
 	}
 
-	return convertDomainToProto(score), nil
+	score.ID = existing.ID
+	return s.repo.UpdateScore(ctx, score, reason)
 }
 
 func (s *scoringService) GetScore(ctx context.Context, req *scoringv1.GetScoreRequest) (*scoringv1.ExamScore, error) {
@@ -76,28 +151,765 @@ func (s *scoringService) GetScore(ctx context.Context, req *scoringv1.GetScoreRe
 }
 
 func (s *scoringService) ListScores(ctx context.Context, req *scoringv1.ListScoresRequest) (*scoringv1.ListScoresResponse, error) {
-	scores, err := s.repo.ListScores(ctx, req.ExamId, req.PageSize, int32(len(req.PageToken)))
+	filter := domain.ScoreFilter{
+		ExamID: req.ExamId,
+		Passed: req.Passed,
+	}
+
+	after, err := pagination.Decode(req.PageToken)
+	if err == nil && after != nil {
+		_, err = strconv.ParseFloat(after.Value, 64)
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, pagination.ErrInvalidToken.Error())
+	}
+
+	// Ambil satu baris lebih untuk mengetahui apakah masih ada halaman berikutnya
+	pageSize := pagination.PageSize(req.PageSize)
+	scores, err := s.repo.ListScores(ctx, filter, pageSize+1, after)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list scores: %v", err)
 	}
 
-	var protoScores []*scoringv1.ExamScore
+	resp := &scoringv1.ListScoresResponse{}
+	if len(scores) > int(pageSize) {
+		scores = scores[:pageSize]
+		last := scores[len(scores)-1]
+		resp.NextPageToken = pagination.Encode(pagination.Cursor{
+			Value: strconv.FormatFloat(float64(last.Score), 'f', -1, 32),
+			ID:    last.ID,
+		})
+	}
 	for _, score := range scores {
-		protoScores = append(protoScores, convertDomainToProto(score))
+		resp.Scores = append(resp.Scores, convertDomainToProto(score))
+	}
+
+	if req.IncludeTotal {
+		resp.TotalSize, err = s.repo.CountScores(ctx, filter)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to count scores: %v", err)
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *scoringService) ExportScores(req *scoringv1.ExportScoresRequest, stream scoringv1.ScoringService_ExportScoresServer) error {
+	if req.ExamId == "" {
+		return status.Error(codes.InvalidArgument, "exam id is required")
+	}
+
+	ctx := stream.Context()
+	if _, err := s.repo.GetScoringPolicy(ctx, req.ExamId); err != nil {
+		if errors.Is(err, repository.ErrExamNotFound) {
+			return status.Error(codes.NotFound, "exam not found")
+		}
+		return status.Errorf(codes.Internal, "failed to get exam: %v", err)
+	}
+
+	err := s.repo.ExportScores(ctx, req.ExamId, func(row *domain.ScoreExportRow) error {
+		return stream.Send(&scoringv1.ScoreExportRow{
+			Score:       convertDomainToProto(row.Score),
+			StudentName: row.StudentName,
+			ClassId:     row.ClassID,
+		})
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Errorf(codes.Internal, "failed to export scores: %v", err)
+	}
+
+	return nil
+}
+
+func (s *scoringService) ListPendingGrading(ctx context.Context, req *scoringv1.ListPendingGradingRequest) (*scoringv1.ListPendingGradingResponse, error) {
+	after, err := pagination.Decode(req.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, pagination.ErrInvalidToken.Error())
+	}
+
+	pageSize := pagination.PageSize(req.PageSize)
+	pending, err := s.repo.ListPendingGrading(ctx, req.ExamId, pageSize+1, after)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list pending grading: %v", err)
+	}
+
+	resp := &scoringv1.ListPendingGradingResponse{}
+	if len(pending) > int(pageSize) {
+		pending = pending[:pageSize]
+		last := pending[len(pending)-1]
+		resp.NextPageToken = pagination.Encode(pagination.Cursor{Time: last.AnsweredAt, ID: last.AnswerID})
+	}
+	for _, p := range pending {
+		resp.Answers = append(resp.Answers, &scoringv1.PendingAnswer{
+			SessionId:    p.SessionID,
+			ExamId:       p.ExamID,
+			StudentId:    p.StudentID,
+			QuestionId:   p.QuestionID,
+			QuestionText: p.QuestionText,
+			AnswerText:   p.AnswerText,
+			AnsweredAt:   timestamppb.New(p.AnsweredAt),
+		})
+	}
+
+	if req.IncludeTotal {
+		resp.TotalSize, err = s.repo.CountPendingGrading(ctx, req.ExamId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to count pending grading: %v", err)
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *scoringService) GradeAnswer(ctx context.Context, req *scoringv1.GradeAnswerRequest) (*scoringv1.GradeAnswerResponse, error) {
+	if req.Points < 0 {
+		return nil, status.Error(codes.InvalidArgument, "points must not be negative")
+	}
+
+	session, err := s.repo.GetSessionInfo(ctx, req.SessionId)
+	if err != nil {
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			return nil, status.Error(codes.NotFound, "session not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get session: %v", err)
+	}
+	if !session.IsClosed() {
+		return nil, status.Error(codes.FailedPrecondition, "session is still in progress")
+	}
+
+	answer, err := s.repo.GetAnswer(ctx, req.SessionId, req.QuestionId)
+	if err != nil {
+		if errors.Is(err, repository.ErrQuestionNotInSession) {
+			return nil, status.Error(codes.NotFound, "question is not part of this session")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get answer: %v", err)
+	}
+	if !answer.IsManuallyGraded() {
+		return nil, status.Error(codes.FailedPrecondition, "question is graded automatically")
+	}
+	if !answer.IsAnswered() {
+		return nil, status.Error(codes.FailedPrecondition, "question was not answered")
+	}
+	if float64(req.Points) > answer.Points {
+		return nil, status.Errorf(codes.InvalidArgument, "points must not exceed the question's %g points", answer.Points)
+	}
+
+	grade := &domain.AnswerGrade{
+		SessionID:  req.SessionId,
+		QuestionID: req.QuestionId,
+		Points:     float64(req.Points),
+		Comment:    req.Comment,
+	}
+	if err := s.repo.SaveGrade(ctx, grade); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save grade: %v", err)
 	}
 
-	// Simple pagination using last score's ID as next page token
-	var nextPageToken string
-	if len(scores) == int(req.PageSize) {
-		nextPageToken = scores[len(scores)-1].ID
+	// Hitung ulang nilai sesi agar status PROVISIONAL/FINAL ikut diperbarui
+	score, err := s.calculate(ctx, req.SessionId, domain.ScoreChangeManualGrade)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to recalculate score: %v", err)
 	}
 
-	return &scoringv1.ListScoresResponse{
-		Scores:        protoScores,
-		NextPageToken: nextPageToken,
+	return &scoringv1.GradeAnswerResponse{
+		Grade: &scoringv1.AnswerGrade{
+			SessionId:  grade.SessionID,
+			QuestionId: grade.QuestionID,
+			Points:     float32(grade.Points),
+			Comment:    grade.Comment,
+			GradedAt:   timestamppb.New(grade.GradedAt),
+		},
+		Score: convertDomainToProto(score),
 	}, nil
 }
 
+// RegradeExam menghitung ulang semua nilai ujian, atau hanya sesi yang memuat
+// question_id, setelah kunci jawaban diubah. Sesi yang gagal dinilai ulang
+// dicatat di failures tanpa menghentikan sesi lainnya.
+func (s *scoringService) RegradeExam(ctx context.Context, req *scoringv1.RegradeExamRequest) (*scoringv1.RegradeExamResponse, error) {
+	if req.ExamId == "" {
+		return nil, status.Error(codes.InvalidArgument, "exam id is required")
+	}
+
+	if _, err := s.repo.GetScoringPolicy(ctx, req.ExamId); err != nil {
+		if errors.Is(err, repository.ErrExamNotFound) {
+			return nil, status.Error(codes.NotFound, "exam not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get exam: %v", err)
+	}
+
+	sessionIDs, err := s.repo.ListClosedSessions(ctx, req.ExamId, req.QuestionId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list sessions: %v", err)
+	}
+
+	resp := &scoringv1.RegradeExamResponse{}
+	for _, sessionID := range sessionIDs {
+		change, err := s.regrade(ctx, sessionID)
+		if err != nil {
+			resp.Failures = append(resp.Failures, &scoringv1.RegradeFailure{
+				SessionId: sessionID,
+				Error:     err.Error(),
+			})
+			continue
+		}
+
+		resp.SessionsRegraded++
+		if !change.Changed() {
+			continue
+		}
+
+		protoChange := &scoringv1.ScoreChange{
+			SessionId: change.SessionID,
+			StudentId: change.StudentID,
+			Current:   convertDomainToProto(change.Current),
+		}
+		if change.Previous != nil {
+			protoChange.Previous = convertDomainToProto(change.Previous)
+		}
+		resp.Changes = append(resp.Changes, protoChange)
+	}
+
+	return resp, nil
+}
+
+// regrade menilai ulang satu sesi dan hanya menyimpannya (beserta riwayat)
+// jika nilai atau rinciannya berubah
+func (s *scoringService) regrade(ctx context.Context, sessionID string) (*domain.ScoreChange, error) {
+	current, err := s.grade(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := s.repo.GetScoreByExamAndStudent(ctx, current.ExamID, current.StudentID)
+	if err != nil && !errors.Is(err, repository.ErrScoreNotFound) {
+		return nil, err
+	}
+
+	change := &domain.ScoreChange{
+		SessionID: sessionID,
+		StudentID: current.StudentID,
+		Previous:  previous,
+		Current:   current,
+	}
+	if !change.Changed() {
+		return change, nil
+	}
+
+	if err := s.save(ctx, current, previous, domain.ScoreChangeRegrade); err != nil {
+		return nil, err
+	}
+
+	return change, nil
+}
+
+func (s *scoringService) SetGradingScale(ctx context.Context, req *scoringv1.SetGradingScaleRequest) (*scoringv1.GradingScale, error) {
+	if req.Scale == nil {
+		return nil, status.Error(codes.InvalidArgument, "grading scale is required")
+	}
+
+	scale := &domain.GradingScale{
+		ExamID:   req.Scale.ExamId,
+		Subject:  req.Scale.Subject,
+		PassMark: float64(req.Scale.PassMark),
+	}
+	for _, b := range req.Scale.Bands {
+		scale.Bands = append(scale.Bands, domain.GradeBand{
+			Grade:    b.Grade,
+			MinScore: float64(b.MinScore),
+		})
+	}
+
+	if err := scale.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.repo.SaveGradingScale(ctx, scale); err != nil {
+		if errors.Is(err, repository.ErrExamNotFound) {
+			return nil, status.Error(codes.NotFound, "exam not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to save grading scale: %v", err)
+	}
+
+	// Kelulusan dan predikat yang tersimpan dipakai oleh filter ListScores,
+	// jadi langsung disesuaikan dengan skala baru
+	if err := s.applyScale(ctx, scale); err != nil {
+		return nil, status.Errorf(codes.Internal, "grading scale saved but failed to update scores: %v", err)
+	}
+
+	return convertScaleToProto(scale), nil
+}
+
+// applyScale menerapkan skala ke semua nilai yang mengikutinya dan hanya
+// menyimpan nilai yang kelulusan atau predikatnya berubah
+func (s *scoringService) applyScale(ctx context.Context, scale *domain.GradingScale) error {
+	examIDs, err := s.repo.ListExamsUsingScale(ctx, scale)
+	if err != nil {
+		return err
+	}
+
+	for _, examID := range examIDs {
+		scores, err := s.repo.ListExamScores(ctx, examID)
+		if err != nil {
+			return err
+		}
+
+		for _, previous := range scores {
+			current := *previous
+			current.ApplyScale(scale)
+
+			change := domain.ScoreChange{Previous: previous, Current: &current}
+			if !change.Changed() {
+				continue
+			}
+			if err := s.repo.UpdateScore(ctx, &current, domain.ScoreChangeGradingScale); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *scoringService) GetGradingScale(ctx context.Context, req *scoringv1.GetGradingScaleRequest) (*scoringv1.GradingScale, error) {
+	var scale *domain.GradingScale
+	var err error
+	switch {
+	case req.ExamId != "":
+		scale, err = s.repo.GetGradingScale(ctx, req.ExamId)
+	case req.Subject != "":
+		scale, err = s.repo.GetSubjectGradingScale(ctx, req.Subject)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "exam id or subject is required")
+	}
+
+	if err != nil {
+		if errors.Is(err, repository.ErrGradingScaleNotFound) {
+			return nil, status.Error(codes.NotFound, "grading scale not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get grading scale: %v", err)
+	}
+
+	return convertScaleToProto(scale), nil
+}
+
+func (s *scoringService) GetItemAnalysis(ctx context.Context, req *scoringv1.GetItemAnalysisRequest) (*scoringv1.GetItemAnalysisResponse, error) {
+	if req.ExamId == "" {
+		return nil, status.Error(codes.InvalidArgument, "exam id is required")
+	}
+
+	// Pastikan ujian ada
+	if _, err := s.repo.GetScoringPolicy(ctx, req.ExamId); err != nil {
+		if errors.Is(err, repository.ErrExamNotFound) {
+			return nil, status.Error(codes.NotFound, "exam not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get exam: %v", err)
+	}
+
+	items, err := s.repo.ListExamItems(ctx, req.ExamId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list questions: %v", err)
+	}
+
+	sessions, err := s.repo.ListExamAnswers(ctx, req.ExamId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list answers: %v", err)
+	}
+
+	resp := &scoringv1.GetItemAnalysisResponse{
+		ExamId:   req.ExamId,
+		Sessions: int32(len(sessions)),
+	}
+	for _, item := range domain.AnalyzeItems(items, sessions) {
+		resp.Items = append(resp.Items, convertItemToProto(item))
+	}
+
+	return resp, nil
+}
+
+func (s *scoringService) GetSessionReport(ctx context.Context, req *scoringv1.GetSessionReportRequest) (*scoringv1.ReportFile, error) {
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session id is required")
+	}
+
+	file, err := s.renderReport(ctx, req.SessionId, req.IncludeQuestions)
+	if err != nil {
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			return nil, status.Error(codes.NotFound, "session not found")
+		}
+		if errors.Is(err, repository.ErrScoreNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, "score has not been calculated for this session")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to render report: %v", err)
+	}
+
+	return file, nil
+}
+
+func (s *scoringService) ExportExamReports(req *scoringv1.ExportExamReportsRequest, stream scoringv1.ScoringService_ExportExamReportsServer) error {
+	if req.ExamId == "" {
+		return status.Error(codes.InvalidArgument, "exam id is required")
+	}
+
+	ctx := stream.Context()
+	if _, err := s.repo.GetScoringPolicy(ctx, req.ExamId); err != nil {
+		if errors.Is(err, repository.ErrExamNotFound) {
+			return status.Error(codes.NotFound, "exam not found")
+		}
+		return status.Errorf(codes.Internal, "failed to get exam: %v", err)
+	}
+
+	sessionIDs, err := s.repo.ListClosedSessions(ctx, req.ExamId, "")
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list sessions: %v", err)
+	}
+
+	for _, sessionID := range sessionIDs {
+		file, err := s.renderReport(ctx, sessionID, req.IncludeQuestions)
+		if errors.Is(err, repository.ErrScoreNotFound) {
+			// Nilai belum dihitung oleh consumer; lewati sesi ini
+			continue
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to render report for session %s: %v", sessionID, err)
+		}
+		if err := stream.Send(file); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *scoringService) renderReport(ctx context.Context, sessionID string, includeQuestions bool) (*scoringv1.ReportFile, error) {
+	r, err := s.repo.GetReportHeader(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.Score, err = s.repo.GetScoreBySession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if includeQuestions {
+		r.Questions, err = s.repo.ListReportQuestions(ctx, sessionID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := report.Render(&buf, r); err != nil {
+		return nil, err
+	}
+
+	return &scoringv1.ReportFile{
+		SessionId: sessionID,
+		Filename:  report.Filename(r),
+		Content:   buf.Bytes(),
+	}, nil
+}
+
+// maxHistogramBuckets membatasi ukuran histogram yang diminta
+const maxHistogramBuckets = 100
+
+func (s *scoringService) GetExamStatistics(ctx context.Context, req *scoringv1.GetExamStatisticsRequest) (*scoringv1.ExamStatistics, error) {
+	if req.ExamId == "" {
+		return nil, status.Error(codes.InvalidArgument, "exam id is required")
+	}
+	if req.Buckets < 0 || req.Buckets > maxHistogramBuckets {
+		return nil, status.Errorf(codes.InvalidArgument, "buckets must be between 0 and %d (0 = default)", maxHistogramBuckets)
+	}
+
+	policy, err := s.repo.GetScoringPolicy(ctx, req.ExamId)
+	if err != nil {
+		if errors.Is(err, repository.ErrExamNotFound) {
+			return nil, status.Error(codes.NotFound, "exam not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get exam: %v", err)
+	}
+
+	samples, err := s.repo.ListScoreSamples(ctx, req.ExamId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list scores: %v", err)
+	}
+
+	classIDs, err := s.repo.ListExamClasses(ctx, req.ExamId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list exam classes: %v", err)
+	}
+
+	sessions, err := s.repo.ListExamAnswers(ctx, req.ExamId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list answers: %v", err)
+	}
+
+	stats := domain.ExamStatistics{
+		ExamID: req.ExamId,
+		KR20:   domain.KR20(sessions),
+	}
+
+	// Rentang histogram mengikuti skala nilai ujian; untuk RAW_POINTS batas
+	// atasnya adalah poin maksimal terbesar di antara sesi
+	var upper float64
+	switch policy.Policy {
+	case domain.ScorePolicyScaled:
+		upper = policy.ScoreMax
+	case domain.ScorePolicyRawPoints:
+	default:
+		upper = 100
+	}
+	var lower float64
+	var scores []float64
+	byClass := make(map[string][]float64)
+	for _, sample := range samples {
+		scores = append(scores, sample.Score)
+		byClass[sample.ClassID] = append(byClass[sample.ClassID], sample.Score)
+		if policy.Policy == domain.ScorePolicyRawPoints && sample.MaxPoints > upper {
+			upper = sample.MaxPoints
+		}
+		if sample.Score < lower {
+			lower = sample.Score
+		}
+		if sample.Score > upper {
+			upper = sample.Score
+		}
+	}
+	stats.Distribution = domain.Describe(scores)
+	stats.Histogram = domain.Histogram(scores, lower, upper, int(req.Buckets))
+
+	// Kelas ujian selalu ditampilkan, lalu kelas lain yang memiliki nilai
+	seen := make(map[string]bool)
+	for _, classID := range classIDs {
+		seen[classID] = true
+		stats.Classes = append(stats.Classes, domain.ClassStatistics{
+			ClassID:      classID,
+			Distribution: domain.Describe(byClass[classID]),
+		})
+	}
+	var others []string
+	for classID := range byClass {
+		if !seen[classID] && classID != "" {
+			others = append(others, classID)
+		}
+	}
+	sort.Strings(others)
+	for _, classID := range others {
+		stats.Classes = append(stats.Classes, domain.ClassStatistics{
+			ClassID:      classID,
+			Distribution: domain.Describe(byClass[classID]),
+		})
+	}
+
+	return convertStatisticsToProto(stats), nil
+}
+
+func (s *scoringService) AnalyzeCollusion(ctx context.Context, req *scoringv1.AnalyzeCollusionRequest) (*scoringv1.AnalyzeCollusionResponse, error) {
+	if req.ExamId == "" {
+		return nil, status.Error(codes.InvalidArgument, "exam id is required")
+	}
+
+	result, err := s.analyzeCollusion(ctx, req.ExamId)
+	if err != nil {
+		if errors.Is(err, repository.ErrExamNotFound) {
+			return nil, status.Error(codes.NotFound, "exam not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to analyze collusion: %v", err)
+	}
+
+	flags, err := s.repo.ListCollusionFlags(ctx, req.ExamId, "")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list collusion flags: %v", err)
+	}
+
+	resp := &scoringv1.AnalyzeCollusionResponse{
+		ExamId:        req.ExamId,
+		Sessions:      result.Sessions,
+		PairsCompared: result.PairsCompared,
+	}
+	for _, flag := range flags {
+		resp.Flags = append(resp.Flags, convertFlagToProto(flag))
+	}
+
+	return resp, nil
+}
+
+func (s *scoringService) analyzeCollusion(ctx context.Context, examID string) (*domain.CollusionResult, error) {
+	sessions, err := s.repo.ListExamAnswers(ctx, examID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := domain.DetectCollusion(examID, sessions)
+	if err := s.repo.SaveCollusionResult(ctx, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (s *scoringService) ListCollusionFlags(ctx context.Context, req *scoringv1.ListCollusionFlagsRequest) (*scoringv1.ListCollusionFlagsResponse, error) {
+	if req.ExamId == "" {
+		return nil, status.Error(codes.InvalidArgument, "exam id is required")
+	}
+
+	flags, err := s.repo.ListCollusionFlags(ctx, req.ExamId, convertCollusionStatusToDomain(req.Status))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list collusion flags: %v", err)
+	}
+
+	resp := &scoringv1.ListCollusionFlagsResponse{}
+	for _, flag := range flags {
+		resp.Flags = append(resp.Flags, convertFlagToProto(flag))
+	}
+
+	return resp, nil
+}
+
+func (s *scoringService) ReviewCollusionFlag(ctx context.Context, req *scoringv1.ReviewCollusionFlagRequest) (*scoringv1.CollusionFlag, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "flag id is required")
+	}
+
+	reviewStatus := convertCollusionStatusToDomain(req.Status)
+	if reviewStatus != domain.CollusionConfirmed && reviewStatus != domain.CollusionDismissed {
+		return nil, status.Error(codes.InvalidArgument, "status must be CONFIRMED or DISMISSED")
+	}
+
+	flag, err := s.repo.ReviewCollusionFlag(ctx, req.Id, reviewStatus, req.Note, req.ReviewedBy)
+	if err != nil {
+		if errors.Is(err, repository.ErrCollusionFlagNotFound) {
+			return nil, status.Error(codes.NotFound, "collusion flag not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to review collusion flag: %v", err)
+	}
+
+	return convertFlagToProto(flag), nil
+}
+
+func convertFlagToProto(flag *domain.CollusionFlag) *scoringv1.CollusionFlag {
+	protoFlag := &scoringv1.CollusionFlag{
+		Id:          flag.ID,
+		ExamId:      flag.ExamID,
+		SessionA:    flag.SessionA,
+		SessionB:    flag.SessionB,
+		StudentA:    flag.StudentA,
+		StudentB:    flag.StudentB,
+		BothWrong:   flag.BothWrong,
+		SharedWrong: flag.SharedWrong,
+		Expected:    flag.Expected,
+		Probability: flag.Probability,
+		Status:      convertCollusionStatusToProto(flag.Status),
+		ReviewNote:  flag.ReviewNote,
+		ReviewedBy:  flag.ReviewedBy,
+		CreatedAt:   timestamppb.New(flag.CreatedAt),
+	}
+	if flag.ReviewedAt != nil {
+		protoFlag.ReviewedAt = timestamppb.New(*flag.ReviewedAt)
+	}
+	return protoFlag
+}
+
+func convertCollusionStatusToProto(s domain.CollusionStatus) scoringv1.CollusionStatus {
+	switch s {
+	case domain.CollusionPending:
+		return scoringv1.CollusionStatus_COLLUSION_STATUS_PENDING
+	case domain.CollusionConfirmed:
+		return scoringv1.CollusionStatus_COLLUSION_STATUS_CONFIRMED
+	case domain.CollusionDismissed:
+		return scoringv1.CollusionStatus_COLLUSION_STATUS_DISMISSED
+	default:
+		return scoringv1.CollusionStatus_COLLUSION_STATUS_UNSPECIFIED
+	}
+}
+
+func convertCollusionStatusToDomain(s scoringv1.CollusionStatus) domain.CollusionStatus {
+	switch s {
+	case scoringv1.CollusionStatus_COLLUSION_STATUS_PENDING:
+		return domain.CollusionPending
+	case scoringv1.CollusionStatus_COLLUSION_STATUS_CONFIRMED:
+		return domain.CollusionConfirmed
+	case scoringv1.CollusionStatus_COLLUSION_STATUS_DISMISSED:
+		return domain.CollusionDismissed
+	default:
+		return ""
+	}
+}
+
+func convertStatisticsToProto(stats domain.ExamStatistics) *scoringv1.ExamStatistics {
+	protoStats := &scoringv1.ExamStatistics{
+		ExamId:       stats.ExamID,
+		Distribution: convertDistributionToProto(stats.Distribution),
+		Kr20:         stats.KR20,
+	}
+	for _, b := range stats.Histogram {
+		protoStats.Histogram = append(protoStats.Histogram, &scoringv1.HistogramBucket{
+			Lower: b.Lower,
+			Upper: b.Upper,
+			Count: b.Count,
+		})
+	}
+	for _, c := range stats.Classes {
+		protoStats.Classes = append(protoStats.Classes, &scoringv1.ClassStatistics{
+			ClassId:      c.ClassID,
+			Distribution: convertDistributionToProto(c.Distribution),
+		})
+	}
+	return protoStats
+}
+
+func convertDistributionToProto(d domain.Distribution) *scoringv1.ScoreDistribution {
+	return &scoringv1.ScoreDistribution{
+		Count:  d.Count,
+		Mean:   d.Mean,
+		Median: d.Median,
+		StdDev: d.StdDev,
+		Min:    d.Min,
+		Max:    d.Max,
+	}
+}
+
+func convertItemToProto(item domain.ItemAnalysis) *scoringv1.ItemAnalysis {
+	protoItem := &scoringv1.ItemAnalysis{
+		QuestionId:     item.QuestionID,
+		QuestionText:   item.QuestionText,
+		QuestionType:   item.QuestionType,
+		CorrectAnswer:  item.CorrectAnswer,
+		Responses:      item.Responses,
+		Omitted:        item.Omitted,
+		Difficulty:     item.Difficulty,
+		Discrimination: item.Discrimination,
+	}
+	for _, c := range item.Choices {
+		protoItem.Choices = append(protoItem.Choices, &scoringv1.ChoiceFrequency{
+			Choice:     c.Choice,
+			Count:      c.Count,
+			Proportion: c.Proportion,
+			Correct:    c.Correct,
+		})
+	}
+	return protoItem
+}
+
+func convertScaleToProto(scale *domain.GradingScale) *scoringv1.GradingScale {
+	protoScale := &scoringv1.GradingScale{
+		Id:       scale.ID,
+		ExamId:   scale.ExamID,
+		Subject:  scale.Subject,
+		PassMark: float32(scale.PassMark),
+	}
+	for _, b := range scale.Bands {
+		protoScale.Bands = append(protoScale.Bands, &scoringv1.GradeBand{
+			Grade:    b.Grade,
+			MinScore: float32(b.MinScore),
+		})
+	}
+	return protoScale
+}
+
 // Helper function untuk konversi domain ke proto
 func convertDomainToProto(score *domain.ExamScore) *scoringv1.ExamScore {
 	return &scoringv1.ExamScore{
@@ -110,6 +922,25 @@ func convertDomainToProto(score *domain.ExamScore) *scoringv1.ExamScore {
 		WrongAnswers:   score.WrongAnswers,
 		Unanswered:     score.UnansweredCount,
 		Score:          score.Score,
+		RawPoints:      score.RawPoints,
+		PenaltyPoints:  score.PenaltyPoints,
+		MaxPoints:      score.MaxPoints,
 		CreatedAt:      timestamppb.New(score.CreatedAt),
+		UpdatedAt:      timestamppb.New(score.UpdatedAt),
+		Status:         convertStatusToProto(score.Status),
+		PendingGrading: score.PendingGrading,
+		Passed:         score.Passed,
+		Grade:          score.Grade,
+	}
+}
+
+func convertStatusToProto(status domain.ScoreStatus) scoringv1.ScoreStatus {
+	switch status {
+	case domain.ScoreStatusProvisional:
+		return scoringv1.ScoreStatus_SCORE_STATUS_PROVISIONAL
+	case domain.ScoreStatusFinal:
+		return scoringv1.ScoreStatus_SCORE_STATUS_FINAL
+	default:
+		return scoringv1.ScoreStatus_SCORE_STATUS_UNSPECIFIED
 	}
 }
@@ -1,7 +1,14 @@
 package domain
 
 import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
+
+	"github.com/ApesJs/cbt-exam/pkg/choiceset"
+	"github.com/ApesJs/cbt-exam/pkg/shuffle"
 )
 
 type SessionStatus string
@@ -14,30 +21,132 @@ const (
 )
 
 type ExamSession struct {
-	ID        string        `json:"id"`
-	ExamID    string        `json:"exam_id"`
-	StudentID string        `json:"student_id"`
-	Status    SessionStatus `json:"status"`
-	StartTime time.Time     `json:"start_time"`
-	EndTime   time.Time     `json:"end_time"`
-	Answers   []Answer      `json:"answers"`
-	CreatedAt time.Time     `json:"created_at"`
-	UpdatedAt time.Time     `json:"updated_at"`
+	ID            string        `json:"id"`
+	ExamID        string        `json:"exam_id"`
+	StudentID     string        `json:"student_id"`
+	Status        SessionStatus `json:"status"`
+	StartTime     time.Time     `json:"start_time"`
+	EndTime       time.Time     `json:"end_time"`
+	ExtraTimeMins int32         `json:"extra_time_minutes"`
+	QuestionIDs   []string      `json:"question_ids"`
+	ChoiceSeed    int64         `json:"-"`
+	Answers       []Answer      `json:"answers"`
+	CreatedAt     time.Time     `json:"created_at"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+}
+
+// ExpiredSession adalah sesi berjalan yang sudah melewati batas waktunya
+type ExpiredSession struct {
+	ExamSession
+	// ForceClosed bernilai true jika ujian ditutup sebelum durasi siswa habis
+	ForceClosed bool
 }
 
+type SessionEventType string
+
+// Event yang dicatat saat sesi ditutup; ScoringService mengonsumsinya untuk menilai sesi
+const (
+	SessionEventFinished    SessionEventType = "SESSION_FINISHED"
+	SessionEventTimeout     SessionEventType = "SESSION_TIMEOUT"
+	SessionEventForceClosed SessionEventType = "SESSION_FORCE_CLOSED"
+)
+
 type Answer struct {
 	QuestionID     string    `json:"question_id"`
 	SelectedChoice string    `json:"selected_choice"`
+	AnswerText     string    `json:"answer_text"`
 	AnsweredAt     time.Time `json:"answered_at"`
 }
 
+// ChoiceLayout menyimpan seed pengacakan dan pilihan tiap soal dalam sesi,
+// dipakai untuk menerjemahkan huruf yang ditampilkan ke huruf kanonik dan sebaliknya
+type ChoiceLayout struct {
+	Seed      int64
+	Questions map[string]QuestionChoices
+}
+
+// QuestionChoices berisi ID pilihan sebuah soal dalam urutan kanonik
+type QuestionChoices struct {
+	MultipleAnswer bool
+	// FreeText bernilai true untuk soal yang dijawab dengan teks (ESSAY, SHORT_ANSWER)
+	FreeText  bool
+	ChoiceIDs []string
+}
+
+func (l *ChoiceLayout) Has(questionID string) bool {
+	_, ok := l.Questions[questionID]
+	return ok
+}
+
+func (l *ChoiceLayout) IsFreeText(questionID string) bool {
+	return l.Questions[questionID].FreeText
+}
+
+// ToCanonical mengubah pilihan siswa, berupa huruf yang ditampilkan dan/atau ID
+// pilihan, menjadi himpunan huruf kanonik yang disimpan
+func (l *ChoiceLayout) ToCanonical(questionID, displayed string, choiceIDs []string) (string, error) {
+	q := l.Questions[questionID]
+
+	var letters []string
+	for _, letter := range choiceset.Parse(displayed) {
+		canonical, err := shuffle.ToCanonical(l.Seed, questionID, len(q.ChoiceIDs), letter)
+		if err != nil {
+			return "", err
+		}
+		letters = append(letters, canonical)
+	}
+	for _, id := range choiceIDs {
+		idx := slices.Index(q.ChoiceIDs, id)
+		if idx < 0 {
+			return "", fmt.Errorf("invalid choice id %q", id)
+		}
+		letters = append(letters, shuffle.Letter(idx))
+	}
+
+	selected := choiceset.Format(letters)
+	switch {
+	case selected == "":
+		return "", errors.New("no choice selected")
+	case !q.MultipleAnswer && strings.Contains(selected, ","):
+		return "", errors.New("question accepts a single choice")
+	}
+
+	return selected, nil
+}
+
+// ToDisplayed mengubah jawaban kanonik yang tersimpan menjadi huruf yang dilihat siswa
+func (l *ChoiceLayout) ToDisplayed(questionID, canonical string) (string, error) {
+	n := len(l.Questions[questionID].ChoiceIDs)
+
+	var letters []string
+	for _, letter := range choiceset.Parse(canonical) {
+		displayed, err := shuffle.ToDisplayed(l.Seed, questionID, n, letter)
+		if err != nil {
+			return "", err
+		}
+		letters = append(letters, displayed)
+	}
+
+	return choiceset.Format(letters), nil
+}
+
 type RemainingTime struct {
 	Minutes int32 `json:"remaining_minutes"`
 	Seconds int32 `json:"remaining_seconds"`
 }
 
+// Deadline mengembalikan batas waktu sesi: durasi ujian ditambah waktu tambahan
+// siswa, namun tidak melewati waktu tutup ujian (closeTime kosong berarti tanpa batas)
+func (s *ExamSession) Deadline(durationMinutes int32, closeTime time.Time) time.Time {
+	deadline := s.StartTime.Add(time.Duration(durationMinutes+s.ExtraTimeMins) * time.Minute)
+	if !closeTime.IsZero() && closeTime.Before(deadline) {
+		return closeTime
+	}
+	return deadline
+}
+
 // Helper function untuk menghitung sisa waktu
-func (s *ExamSession) CalculateRemainingTime(durationMinutes int32) *RemainingTime {
+func (s *ExamSession) CalculateRemainingTime(durationMinutes int32, closeTime time.Time) *RemainingTime {
 	if s.Status == SessionStatusFinished || s.Status == SessionStatusTimeout {
 		return &RemainingTime{
 			Minutes: 0,
@@ -45,8 +154,7 @@ func (s *ExamSession) CalculateRemainingTime(durationMinutes int32) *RemainingTi
 		}
 	}
 
-	endTime := s.StartTime.Add(time.Duration(durationMinutes) * time.Minute)
-	remaining := time.Until(endTime)
+	remaining := time.Until(s.Deadline(durationMinutes, closeTime))
 
 	if remaining < 0 {
 		return &RemainingTime{
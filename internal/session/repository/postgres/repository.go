@@ -3,6 +3,9 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 
 	"github.com/ApesJs/cbt-exam/internal/session/domain"
@@ -13,6 +16,27 @@ type postgresRepository struct {
 	db *sql.DB
 }
 
+// examWindowCondition bernilai true jika waktu sekarang berada di dalam jadwal ujian.
+// Ujian tanpa jadwal dianggap selalu berada di dalam jendelanya.
+const examWindowCondition = `
+        (scheduled_start_time IS NULL OR scheduled_start_time <= CURRENT_TIMESTAMP)
+        AND (scheduled_end_time IS NULL OR scheduled_end_time > CURRENT_TIMESTAMP)`
+
+// enrollmentCondition bernilai true jika siswa $2 terdaftar di salah satu kelas
+// ujian $1. Ujian tanpa kelas tidak dapat diikuti siapa pun; aktivasi ujian
+// seperti itu sudah ditolak oleh exam service.
+const enrollmentCondition = `EXISTS (
+            SELECT 1 FROM exam_classes ec
+            JOIN class_students cs ON cs.class_id = ec.class_id
+            WHERE ec.exam_id = $1 AND cs.student_id = $2)`
+
+// sessionDeadline adalah batas waktu pengerjaan sesi es pada ujian e: durasi ujian
+// ditambah waktu tambahan siswa, dibatasi oleh jadwal tutup atau waktu selesai ujian
+const sessionDeadline = `LEAST(
+            es.start_time + make_interval(mins => e.duration_mins + es.extra_time_mins),
+            COALESCE(e.scheduled_end_time, 'infinity'),
+            COALESCE(e.end_time, 'infinity'))`
+
 func NewPostgresRepository(db *sql.DB) repository.SessionRepository {
 	return &postgresRepository{
 		db: db,
@@ -26,12 +50,13 @@ func (r *postgresRepository) StartSession(ctx context.Context, session *domain.E
 	}
 	defer tx.Rollback()
 
-	// Verify exam is active
-	var examActive bool
+	// Verify exam is active and inside its scheduled window
+	var examActive, withinWindow, isRandom, shuffleChoices bool
+	var totalQuestions int32
 	err = tx.QueryRowContext(ctx,
-		"SELECT status = 'ACTIVE' FROM exams WHERE id = $1",
+		"SELECT status = 'ACTIVE', "+examWindowCondition+", is_random, shuffle_choices, total_questions FROM exams WHERE id = $1",
 		session.ExamID,
-	).Scan(&examActive)
+	).Scan(&examActive, &withinWindow, &isRandom, &shuffleChoices, &totalQuestions)
 
 	if err == sql.ErrNoRows {
 		return repository.ErrExamNotFound
@@ -42,6 +67,19 @@ func (r *postgresRepository) StartSession(ctx context.Context, session *domain.E
 	if !examActive {
 		return repository.ErrExamNotActive
 	}
+	if !withinWindow {
+		return repository.ErrExamOutsideWindow
+	}
+
+	// Verify the student belongs to one of the exam's classes
+	var enrolled bool
+	err = tx.QueryRowContext(ctx, "SELECT "+enrollmentCondition, session.ExamID, session.StudentID).Scan(&enrolled)
+	if err != nil {
+		return errors.Wrap(err, "failed to check class enrollment")
+	}
+	if !enrolled {
+		return repository.ErrStudentNotEnrolled
+	}
 
 	// Check for existing active session
 	var activeCount int
@@ -57,10 +95,15 @@ func (r *postgresRepository) StartSession(ctx context.Context, session *domain.E
 		return repository.ErrDuplicateSession
 	}
 
+	// Seed is only kept when the exam shuffles choices
+	if !shuffleChoices {
+		session.ChoiceSeed = 0
+	}
+
 	// Insert new session
 	query := `
-        INSERT INTO exam_sessions (exam_id, student_id, status, start_time)
-        VALUES ($1, $2, $3, $4)
+        INSERT INTO exam_sessions (exam_id, student_id, status, start_time, choice_seed)
+        VALUES ($1, $2, $3, $4, $5)
         RETURNING id, created_at, updated_at`
 
 	err = tx.QueryRowContext(
@@ -70,20 +113,91 @@ func (r *postgresRepository) StartSession(ctx context.Context, session *domain.E
 		session.StudentID,
 		session.Status,
 		session.StartTime,
+		session.ChoiceSeed,
 	).Scan(&session.ID, &session.CreatedAt, &session.UpdatedAt)
 	if err != nil {
 		return errors.Wrap(err, "failed to create session")
 	}
 
+	// Mark the student as started on the exam roster. The row is normally seeded
+	// on activation; students enrolled afterwards get one from their class.
+	_, err = tx.ExecContext(ctx, `
+        INSERT INTO exam_student_status (exam_id, student_id, student_name, class_id, state, start_time)
+        SELECT ec.exam_id, s.id, s.name, ec.class_id, 'IN_PROGRESS', $3
+        FROM exam_classes ec
+        JOIN class_students cs ON cs.class_id = ec.class_id
+        JOIN students s ON s.id = cs.student_id
+        WHERE ec.exam_id = $1 AND s.id = $2
+        ORDER BY ec.class_id
+        LIMIT 1
+        ON CONFLICT (exam_id, student_id) DO UPDATE
+        SET state = EXCLUDED.state,
+            start_time = EXCLUDED.start_time,
+            end_time = NULL,
+            updated_at = CURRENT_TIMESTAMP`,
+		session.ExamID,
+		session.StudentID,
+		session.StartTime,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to update student exam status")
+	}
+
+	// Draw and freeze the question set so refreshes and scoring see the same paper
+	sortKey := "row_number() OVER (ORDER BY created_at, id)"
+	if isRandom {
+		sortKey = "random()"
+	}
+
+	drawQuery := `
+        INSERT INTO session_questions (session_id, question_id, position)
+        SELECT $1, drawn.id, row_number() OVER (ORDER BY drawn.sort_key)
+        FROM (
+            SELECT id, ` + sortKey + ` AS sort_key
+            FROM questions
+            WHERE exam_id = $2
+            ORDER BY sort_key
+            LIMIT NULLIF($3, 0)
+        ) drawn
+        RETURNING question_id, position`
+
+	rows, err := tx.QueryContext(ctx, drawQuery, session.ID, session.ExamID, totalQuestions)
+	if err != nil {
+		return errors.Wrap(err, "failed to draw session questions")
+	}
+	defer rows.Close()
+
+	drawn := make(map[int]string)
+	for rows.Next() {
+		var questionID string
+		var position int
+		if err := rows.Scan(&questionID, &position); err != nil {
+			return errors.Wrap(err, "failed to scan session question")
+		}
+		drawn[position] = questionID
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(err, "failed to draw session questions")
+	}
+	if len(drawn) == 0 {
+		return repository.ErrExamHasNoQuestions
+	}
+
+	session.QuestionIDs = make([]string, len(drawn))
+	for position, questionID := range drawn {
+		session.QuestionIDs[position-1] = questionID
+	}
+
 	return tx.Commit()
 }
 
 func (r *postgresRepository) GetSession(ctx context.Context, id string) (*domain.ExamSession, error) {
 	session := &domain.ExamSession{}
+	var endTime sql.NullTime
 
 	query := `
         SELECT id, exam_id, student_id, status, start_time, end_time, 
-               created_at, updated_at
+               extra_time_mins, choice_seed, created_at, updated_at
         FROM exam_sessions 
         WHERE id = $1`
 
@@ -93,7 +207,9 @@ func (r *postgresRepository) GetSession(ctx context.Context, id string) (*domain
 		&session.StudentID,
 		&session.Status,
 		&session.StartTime,
-		&session.EndTime,
+		&endTime,
+		&session.ExtraTimeMins,
+		&session.ChoiceSeed,
 		&session.CreatedAt,
 		&session.UpdatedAt,
 	)
@@ -104,6 +220,14 @@ func (r *postgresRepository) GetSession(ctx context.Context, id string) (*domain
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get session")
 	}
+	session.EndTime = endTime.Time
+
+	// Get question set
+	questionIDs, err := r.GetSessionQuestionIDs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	session.QuestionIDs = questionIDs
 
 	// Get answers
 	answers, err := r.GetSessionAnswers(ctx, id)
@@ -143,7 +267,127 @@ func (r *postgresRepository) UpdateSessionStatus(ctx context.Context, id string,
 }
 
 func (r *postgresRepository) FinishSession(ctx context.Context, id string) error {
-	return r.UpdateSessionStatus(ctx, id, domain.SessionStatusFinished)
+	return r.closeSession(ctx, id, domain.SessionStatusFinished, domain.SessionEventFinished)
+}
+
+func (r *postgresRepository) TimeoutSession(ctx context.Context, id string, eventType domain.SessionEventType) error {
+	return r.closeSession(ctx, id, domain.SessionStatusTimeout, eventType)
+}
+
+// closeSession menutup sesi yang masih berjalan dan mencatat event penutupannya
+// dalam transaksi yang sama, sehingga event tidak hilang jika service mati.
+// Sesi yang sudah ditutup tidak ditimpa dan menghasilkan ErrInvalidSessionState.
+func (r *postgresRepository) closeSession(ctx context.Context, id string, status domain.SessionStatus, eventType domain.SessionEventType) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+        UPDATE exam_sessions 
+        SET status = $1, end_time = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+        WHERE id = $2 AND status IN ('STARTED', 'IN_PROGRESS')
+        RETURNING exam_id, student_id, end_time`
+
+	var examID, studentID string
+	var endTime time.Time
+	err = tx.QueryRowContext(ctx, query, status, id).Scan(&examID, &studentID, &endTime)
+	if err == sql.ErrNoRows {
+		return repository.ErrInvalidSessionState
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to close session")
+	}
+
+	studentState := "FINISHED"
+	if status == domain.SessionStatusTimeout {
+		studentState = "TIMED_OUT"
+	}
+	_, err = tx.ExecContext(ctx, `
+        UPDATE exam_student_status
+        SET state = $1, end_time = $2, updated_at = CURRENT_TIMESTAMP
+        WHERE exam_id = $3 AND student_id = $4`,
+		studentState, endTime, examID, studentID,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to update student exam status")
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO session_events (session_id, event_type) VALUES ($1, $2)",
+		id,
+		eventType,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to record session event")
+	}
+
+	return tx.Commit()
+}
+
+func (r *postgresRepository) AddExtraTime(ctx context.Context, id string, minutes int32) error {
+	query := `
+        UPDATE exam_sessions 
+        SET extra_time_mins = extra_time_mins + $1, updated_at = CURRENT_TIMESTAMP
+        WHERE id = $2 AND status IN ('STARTED', 'IN_PROGRESS')`
+
+	result, err := r.db.ExecContext(ctx, query, minutes, id)
+	if err != nil {
+		return errors.Wrap(err, "failed to add extra time")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrInvalidSessionState
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) ListExpiredSessions(ctx context.Context, limit int32) ([]*domain.ExpiredSession, error) {
+	query := `
+        SELECT es.id, es.exam_id, es.student_id, es.status, es.start_time,
+               es.extra_time_mins, es.created_at, es.updated_at,
+               es.start_time + make_interval(mins => e.duration_mins + es.extra_time_mins) > CURRENT_TIMESTAMP
+        FROM exam_sessions es
+        JOIN exams e ON e.id = es.exam_id
+        WHERE es.status IN ('STARTED', 'IN_PROGRESS')
+          AND ` + sessionDeadline + ` <= CURRENT_TIMESTAMP
+        ORDER BY es.start_time
+        LIMIT $1`
+
+	rows, err := r.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list expired sessions")
+	}
+	defer rows.Close()
+
+	var sessions []*domain.ExpiredSession
+	for rows.Next() {
+		session := &domain.ExpiredSession{}
+		err := rows.Scan(
+			&session.ID,
+			&session.ExamID,
+			&session.StudentID,
+			&session.Status,
+			&session.StartTime,
+			&session.ExtraTimeMins,
+			&session.CreatedAt,
+			&session.UpdatedAt,
+			&session.ForceClosed,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan expired session")
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, rows.Err()
 }
 
 func (r *postgresRepository) SubmitAnswer(ctx context.Context, sessionID string, answer domain.Answer) error {
@@ -153,12 +397,19 @@ func (r *postgresRepository) SubmitAnswer(ctx context.Context, sessionID string,
 	}
 	defer tx.Rollback()
 
-	// Verify session is active
+	// Verify session is active and still within its time limit. The row stays
+	// locked until commit so the reaper or FinishSession cannot close the
+	// session between this check and the answer insert.
 	var status domain.SessionStatus
+	var expired bool
 	err = tx.QueryRowContext(ctx,
-		"SELECT status FROM exam_sessions WHERE id = $1",
+		`SELECT es.status, `+sessionDeadline+` <= CURRENT_TIMESTAMP
+         FROM exam_sessions es
+         JOIN exams e ON e.id = es.exam_id
+         WHERE es.id = $1
+         FOR UPDATE OF es`,
 		sessionID,
-	).Scan(&status)
+	).Scan(&status, &expired)
 
 	if err == sql.ErrNoRows {
 		return repository.ErrSessionNotFound
@@ -170,19 +421,38 @@ func (r *postgresRepository) SubmitAnswer(ctx context.Context, sessionID string,
 	if status != domain.SessionStatusStarted && status != domain.SessionStatusInProgress {
 		return repository.ErrInvalidSessionState
 	}
+	if expired {
+		return repository.ErrSessionExpired
+	}
+
+	// Only questions from the session's frozen set can be answered
+	var inSession bool
+	err = tx.QueryRowContext(ctx,
+		"SELECT EXISTS (SELECT 1 FROM session_questions WHERE session_id = $1 AND question_id = $2)",
+		sessionID,
+		answer.QuestionID,
+	).Scan(&inSession)
+	if err != nil {
+		return errors.Wrap(err, "failed to check session question")
+	}
+	if !inSession {
+		return repository.ErrQuestionNotInSession
+	}
 
 	// Update or insert answer
 	query := `
-        INSERT INTO session_answers (session_id, question_id, selected_choice, answered_at)
-        VALUES ($1, $2, $3, $4)
+        INSERT INTO session_answers (session_id, question_id, selected_choice, answer_text, answered_at)
+        VALUES ($1, $2, $3, $4, $5)
         ON CONFLICT (session_id, question_id) 
         DO UPDATE SET selected_choice = EXCLUDED.selected_choice,
+                      answer_text = EXCLUDED.answer_text,
                       answered_at = EXCLUDED.answered_at`
 
 	_, err = tx.ExecContext(ctx, query,
 		sessionID,
 		answer.QuestionID,
 		answer.SelectedChoice,
+		answer.AnswerText,
 		answer.AnsweredAt,
 	)
 	if err != nil {
@@ -191,9 +461,14 @@ func (r *postgresRepository) SubmitAnswer(ctx context.Context, sessionID string,
 
 	// Update session status to in progress if it was just started
 	if status == domain.SessionStatusStarted {
-		err = r.UpdateSessionStatus(ctx, sessionID, domain.SessionStatusInProgress)
+		_, err = tx.ExecContext(ctx,
+			`UPDATE exam_sessions
+             SET status = 'IN_PROGRESS', updated_at = CURRENT_TIMESTAMP
+             WHERE id = $1 AND status = 'STARTED'`,
+			sessionID,
+		)
 		if err != nil {
-			return err
+			return errors.Wrap(err, "failed to update session status")
 		}
 	}
 
@@ -202,7 +477,7 @@ func (r *postgresRepository) SubmitAnswer(ctx context.Context, sessionID string,
 
 func (r *postgresRepository) GetSessionAnswers(ctx context.Context, sessionID string) ([]domain.Answer, error) {
 	query := `
-        SELECT question_id, selected_choice, answered_at
+        SELECT question_id, selected_choice, answer_text, answered_at
         FROM session_answers
         WHERE session_id = $1
         ORDER BY answered_at`
@@ -219,6 +494,7 @@ func (r *postgresRepository) GetSessionAnswers(ctx context.Context, sessionID st
 		err := rows.Scan(
 			&answer.QuestionID,
 			&answer.SelectedChoice,
+			&answer.AnswerText,
 			&answer.AnsweredAt,
 		)
 		if err != nil {
@@ -230,6 +506,71 @@ func (r *postgresRepository) GetSessionAnswers(ctx context.Context, sessionID st
 	return answers, nil
 }
 
+func (r *postgresRepository) GetSessionQuestionIDs(ctx context.Context, sessionID string) ([]string, error) {
+	query := `
+        SELECT question_id
+        FROM session_questions
+        WHERE session_id = $1
+        ORDER BY position`
+
+	rows, err := r.db.QueryContext(ctx, query, sessionID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get session questions")
+	}
+	defer rows.Close()
+
+	var questionIDs []string
+	for rows.Next() {
+		var questionID string
+		if err := rows.Scan(&questionID); err != nil {
+			return nil, errors.Wrap(err, "failed to scan session question")
+		}
+		questionIDs = append(questionIDs, questionID)
+	}
+
+	return questionIDs, rows.Err()
+}
+
+func (r *postgresRepository) GetChoiceLayout(ctx context.Context, sessionID string) (*domain.ChoiceLayout, error) {
+	query := `
+        SELECT es.choice_seed, sq.question_id, q.type = 'MULTIPLE_ANSWER',
+               q.type IN ('ESSAY', 'SHORT_ANSWER'),
+               COALESCE(array_agg(c.id ORDER BY c.position, c.id) FILTER (WHERE c.id IS NOT NULL), '{}')
+        FROM exam_sessions es
+        JOIN session_questions sq ON sq.session_id = es.id
+        JOIN questions q ON q.id = sq.question_id
+        LEFT JOIN choices c ON c.question_id = sq.question_id
+        WHERE es.id = $1
+        GROUP BY es.choice_seed, sq.question_id, q.type`
+
+	rows, err := r.db.QueryContext(ctx, query, sessionID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get choice layout")
+	}
+	defer rows.Close()
+
+	layout := &domain.ChoiceLayout{
+		Questions: make(map[string]domain.QuestionChoices),
+	}
+	for rows.Next() {
+		var questionID string
+		var choices domain.QuestionChoices
+		if err := rows.Scan(&layout.Seed, &questionID, &choices.MultipleAnswer, &choices.FreeText, pq.Array(&choices.ChoiceIDs)); err != nil {
+			return nil, errors.Wrap(err, "failed to scan choice layout")
+		}
+		layout.Questions[questionID] = choices
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to get choice layout")
+	}
+
+	if len(layout.Questions) == 0 {
+		return nil, repository.ErrSessionNotFound
+	}
+
+	return layout, nil
+}
+
 func (r *postgresRepository) IsExamActive(ctx context.Context, examID string) (bool, error) {
 	var active bool
 	err := r.db.QueryRowContext(ctx,
@@ -247,6 +588,23 @@ func (r *postgresRepository) IsExamActive(ctx context.Context, examID string) (b
 	return active, nil
 }
 
+func (r *postgresRepository) IsWithinExamWindow(ctx context.Context, examID string) (bool, error) {
+	var within bool
+	err := r.db.QueryRowContext(ctx,
+		"SELECT "+examWindowCondition+" FROM exams WHERE id = $1",
+		examID,
+	).Scan(&within)
+
+	if err == sql.ErrNoRows {
+		return false, repository.ErrExamNotFound
+	}
+	if err != nil {
+		return false, errors.Wrap(err, "failed to check exam window")
+	}
+
+	return within, nil
+}
+
 func (r *postgresRepository) HasActiveSession(ctx context.Context, studentID string) (bool, error) {
 	var count int
 	err := r.db.QueryRowContext(ctx,
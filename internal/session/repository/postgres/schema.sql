@@ -7,21 +7,49 @@ CREATE TABLE exam_sessions (
     status session_status NOT NULL DEFAULT 'STARTED',
     start_time TIMESTAMP WITH TIME ZONE NOT NULL,
     end_time TIMESTAMP WITH TIME ZONE,
+    extra_time_mins INTEGER NOT NULL DEFAULT 0,
+    choice_seed BIGINT NOT NULL DEFAULT 0,
     created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
     UNIQUE (exam_id, student_id)
 );
 
+CREATE TABLE session_questions (
+    session_id UUID NOT NULL REFERENCES exam_sessions(id) ON DELETE CASCADE,
+    question_id UUID NOT NULL REFERENCES questions(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    PRIMARY KEY (session_id, question_id),
+    UNIQUE (session_id, position)
+);
+
 CREATE TABLE session_answers (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     session_id UUID NOT NULL REFERENCES exam_sessions(id) ON DELETE CASCADE,
     question_id UUID NOT NULL REFERENCES questions(id) ON DELETE CASCADE,
-    selected_choice VARCHAR(1) NOT NULL,
+    -- Huruf kanonik, dipisah koma untuk soal MULTIPLE_ANSWER
+    selected_choice TEXT NOT NULL DEFAULT '',
+    -- Jawaban teks bebas untuk soal ESSAY dan SHORT_ANSWER
+    answer_text TEXT NOT NULL DEFAULT '',
     answered_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
     UNIQUE (session_id, question_id)
 );
 
+CREATE TYPE session_event_type AS ENUM ('SESSION_FINISHED', 'SESSION_TIMEOUT', 'SESSION_FORCE_CLOSED');
+
+-- Outbox event penutupan sesi, dikonsumsi oleh scoring service
+CREATE TABLE session_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    session_id UUID NOT NULL REFERENCES exam_sessions(id) ON DELETE CASCADE,
+    event_type session_event_type NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    available_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    processed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
 CREATE INDEX idx_session_exam ON exam_sessions(exam_id);
 CREATE INDEX idx_session_student ON exam_sessions(student_id);
 CREATE INDEX idx_session_status ON exam_sessions(status);
-CREATE INDEX idx_answer_session ON session_answers(session_id);
\ No newline at end of file
+CREATE INDEX idx_answer_session ON session_answers(session_id);
+CREATE INDEX idx_session_events_pending ON session_events(available_at) WHERE processed_at IS NULL;
\ No newline at end of file
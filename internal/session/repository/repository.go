@@ -13,22 +13,35 @@ type SessionRepository interface {
 	GetSession(ctx context.Context, id string) (*domain.ExamSession, error)
 	UpdateSessionStatus(ctx context.Context, id string, status domain.SessionStatus) error
 	FinishSession(ctx context.Context, id string) error
+	TimeoutSession(ctx context.Context, id string, eventType domain.SessionEventType) error
+	AddExtraTime(ctx context.Context, id string, minutes int32) error
+	ListExpiredSessions(ctx context.Context, limit int32) ([]*domain.ExpiredSession, error)
 
 	// Answer management
 	SubmitAnswer(ctx context.Context, sessionID string, answer domain.Answer) error
 	GetSessionAnswers(ctx context.Context, sessionID string) ([]domain.Answer, error)
 
+	// Question set management
+	GetSessionQuestionIDs(ctx context.Context, sessionID string) ([]string, error)
+	GetChoiceLayout(ctx context.Context, sessionID string) (*domain.ChoiceLayout, error)
+
 	// Validations
 	IsExamActive(ctx context.Context, examID string) (bool, error)
+	IsWithinExamWindow(ctx context.Context, examID string) (bool, error)
 	HasActiveSession(ctx context.Context, studentID string) (bool, error)
 }
 
 // Errors
 var (
-	ErrSessionNotFound     = errors.New("session not found")
-	ErrExamNotFound        = errors.New("exam not found")
-	ErrExamNotActive       = errors.New("exam is not active")
-	ErrDuplicateSession    = errors.New("student already has an active session")
-	ErrAnswerExists        = errors.New("answer already exists for this question")
-	ErrInvalidSessionState = errors.New("invalid session state")
+	ErrSessionNotFound      = errors.New("session not found")
+	ErrExamNotFound         = errors.New("exam not found")
+	ErrExamNotActive        = errors.New("exam is not active")
+	ErrExamOutsideWindow    = errors.New("exam is outside its scheduled window")
+	ErrDuplicateSession     = errors.New("student already has an active session")
+	ErrStudentNotEnrolled   = errors.New("student is not enrolled in any class of this exam")
+	ErrAnswerExists         = errors.New("answer already exists for this question")
+	ErrInvalidSessionState  = errors.New("invalid session state")
+	ErrSessionExpired       = errors.New("session time has expired")
+	ErrExamHasNoQuestions   = errors.New("exam has no questions")
+	ErrQuestionNotInSession = errors.New("question is not part of this session")
 )
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	examv1 "github.com/ApesJs/cbt-exam/api/proto/exam/v1"
+	"github.com/ApesJs/cbt-exam/pkg/client"
+)
+
+// examTimingTTL menentukan berapa lama data waktu ujian disimpan sebelum diambil ulang
+const examTimingTTL = time.Minute
+
+// examTiming berisi data ujian yang dibutuhkan untuk menghitung batas waktu sesi
+type examTiming struct {
+	DurationMins int32
+	CloseTime    time.Time
+	fetchedAt    time.Time
+}
+
+// examCache menyimpan durasi dan waktu tutup ujian dari ExamService agar
+// GetRemainingTime yang dipanggil berkali-kali tidak selalu memanggil gRPC
+type examCache struct {
+	client *client.ServiceClient
+	mu     sync.Mutex
+	exams  map[string]examTiming
+}
+
+func newExamCache(client *client.ServiceClient) *examCache {
+	return &examCache{
+		client: client,
+		exams:  make(map[string]examTiming),
+	}
+}
+
+func (c *examCache) Get(ctx context.Context, examID string) (examTiming, error) {
+	c.mu.Lock()
+	timing, ok := c.exams[examID]
+	c.mu.Unlock()
+	if ok && time.Since(timing.fetchedAt) < examTimingTTL {
+		return timing, nil
+	}
+
+	exam, err := c.client.GetExam(ctx, examID)
+	if err != nil {
+		return examTiming{}, err
+	}
+
+	timing = examTiming{
+		DurationMins: exam.DurationMinutes,
+		CloseTime:    examCloseTime(exam),
+		fetchedAt:    time.Now(),
+	}
+
+	c.mu.Lock()
+	c.exams[examID] = timing
+	c.mu.Unlock()
+
+	return timing, nil
+}
+
+// examCloseTime mengembalikan waktu paling awal ujian ditutup: jadwal tutup
+// atau waktu ujian dinonaktifkan. Nilai kosong berarti ujian belum punya batas.
+func examCloseTime(exam *examv1.Exam) time.Time {
+	var closeTime time.Time
+	if exam.ScheduledEndTime != nil {
+		closeTime = exam.ScheduledEndTime.AsTime()
+	}
+
+	if exam.GetStatus().GetState() == examv1.ExamState_EXAM_STATE_FINISHED && exam.EndTime != nil {
+		endTime := exam.EndTime.AsTime()
+		if closeTime.IsZero() || endTime.Before(closeTime) {
+			closeTime = endTime
+		}
+	}
+
+	return closeTime
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ApesJs/cbt-exam/internal/session/domain"
+	"github.com/ApesJs/cbt-exam/internal/session/repository"
+)
+
+// reaperBatchSize membatasi jumlah sesi yang diproses dalam satu putaran
+const reaperBatchSize = 100
+
+// Reaper menutup sesi yang melewati batas waktunya, misalnya karena siswa menutup
+// browser tanpa menekan tombol selesai. Penutupan dicatat sebagai session event
+// sehingga ScoringService menilai sesi tersebut secara otomatis.
+type Reaper struct {
+	repo     repository.SessionRepository
+	interval time.Duration
+}
+
+func NewReaper(repo repository.SessionRepository, interval time.Duration) *Reaper {
+	return &Reaper{
+		repo:     repo,
+		interval: interval,
+	}
+}
+
+// Run memeriksa sesi kedaluwarsa secara berkala sampai ctx dibatalkan
+func (r *Reaper) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		r.reap(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Reaper) reap(ctx context.Context) {
+	sessions, err := r.repo.ListExpiredSessions(ctx, reaperBatchSize)
+	if err != nil {
+		log.Printf("reaper: failed to list expired sessions: %v", err)
+		return
+	}
+
+	for _, session := range sessions {
+		eventType := domain.SessionEventTimeout
+		if session.ForceClosed {
+			eventType = domain.SessionEventForceClosed
+		}
+
+		err := r.repo.TimeoutSession(ctx, session.ID, eventType)
+		if errors.Is(err, repository.ErrInvalidSessionState) {
+			// Siswa menyelesaikan sesi sebelum reaper sempat menutupnya
+			continue
+		}
+		if err != nil {
+			log.Printf("reaper: failed to timeout session %s: %v", session.ID, err)
+			continue
+		}
+
+		log.Printf("reaper: session %s closed with %s", session.ID, eventType)
+	}
+}
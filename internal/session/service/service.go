@@ -2,6 +2,9 @@ package service
 
 import (
 	"context"
+	"math"
+	"math/rand"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -12,16 +15,19 @@ import (
 	sessionv1 "github.com/ApesJs/cbt-exam/api/proto/session/v1"
 	"github.com/ApesJs/cbt-exam/internal/session/domain"
 	"github.com/ApesJs/cbt-exam/internal/session/repository"
+	"github.com/ApesJs/cbt-exam/pkg/client"
 )
 
 type sessionService struct {
-	repo repository.SessionRepository
+	repo  repository.SessionRepository
+	exams *examCache
 	sessionv1.UnimplementedSessionServiceServer
 }
 
-func NewSessionService(repo repository.SessionRepository) sessionv1.SessionServiceServer {
+func NewSessionService(repo repository.SessionRepository, client *client.ServiceClient) sessionv1.SessionServiceServer {
 	return &sessionService{
-		repo: repo,
+		repo:  repo,
+		exams: newExamCache(client),
 	}
 }
 
@@ -39,6 +45,16 @@ func (s *sessionService) StartSession(ctx context.Context, req *sessionv1.StartS
 		return nil, status.Error(codes.FailedPrecondition, "exam is not active")
 	}
 
+	// Validasi waktu sekarang berada dalam jadwal ujian
+	withinWindow, err := s.repo.IsWithinExamWindow(ctx, req.ExamId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check exam window: %v", err)
+	}
+
+	if !withinWindow {
+		return nil, status.Error(codes.FailedPrecondition, "exam is outside its scheduled window")
+	}
+
 	// Validasi siswa tidak memiliki sesi aktif lain
 	hasActive, err := s.repo.HasActiveSession(ctx, req.StudentId)
 	if err != nil {
@@ -49,16 +65,29 @@ func (s *sessionService) StartSession(ctx context.Context, req *sessionv1.StartS
 		return nil, status.Error(codes.FailedPrecondition, "student already has an active session")
 	}
 
-	// Membuat sesi baru
+	// Membuat sesi baru; seed hanya disimpan jika ujian mengacak pilihan
 	session := &domain.ExamSession{
-		ExamID:    req.ExamId,
-		StudentID: req.StudentId,
-		Status:    domain.SessionStatusStarted,
-		StartTime: time.Now(),
+		ExamID:     req.ExamId,
+		StudentID:  req.StudentId,
+		Status:     domain.SessionStatusStarted,
+		StartTime:  time.Now(),
+		ChoiceSeed: rand.Int63n(math.MaxInt64-1) + 1,
 	}
 
 	if err := s.repo.StartSession(ctx, session); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to start session: %v", err)
+		switch {
+		case errors.Is(err, repository.ErrExamNotFound):
+			return nil, status.Error(codes.NotFound, "exam not found")
+		case errors.Is(err, repository.ErrExamNotActive),
+			errors.Is(err, repository.ErrExamOutsideWindow),
+			errors.Is(err, repository.ErrDuplicateSession),
+			errors.Is(err, repository.ErrExamHasNoQuestions):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, repository.ErrStudentNotEnrolled):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to start session: %v", err)
+		}
 	}
 
 	return convertDomainToProto(session), nil
@@ -73,23 +102,57 @@ func (s *sessionService) GetSession(ctx context.Context, req *sessionv1.GetSessi
 		return nil, status.Errorf(codes.Internal, "failed to get session: %v", err)
 	}
 
+	if err := s.displayAnswers(ctx, session); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to map answers: %v", err)
+	}
+
 	return convertDomainToProto(session), nil
 }
 
 func (s *sessionService) SubmitAnswer(ctx context.Context, req *sessionv1.SubmitAnswerRequest) (*sessionv1.SubmitAnswerResponse, error) {
+	layout, err := s.repo.GetChoiceLayout(ctx, req.SessionId)
+	if err != nil {
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			return nil, status.Error(codes.NotFound, "session not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get choice layout: %v", err)
+	}
+	if !layout.Has(req.QuestionId) {
+		return nil, status.Error(codes.InvalidArgument, "question is not part of this session")
+	}
+
 	answer := domain.Answer{
-		QuestionID:     req.QuestionId,
-		SelectedChoice: req.SelectedChoice,
-		AnsweredAt:     time.Now(),
+		QuestionID: req.QuestionId,
+		AnsweredAt: time.Now(),
+	}
+
+	if layout.IsFreeText(req.QuestionId) {
+		if req.SelectedChoice != "" || len(req.SelectedChoiceIds) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "free-text question does not accept choices")
+		}
+		answer.AnswerText = strings.TrimSpace(req.AnswerText)
+	} else {
+		if req.AnswerText != "" {
+			return nil, status.Error(codes.InvalidArgument, "choice question does not accept a text answer")
+		}
+		// Siswa mengirim huruf sesuai urutan yang ditampilkan atau ID pilihan; simpan huruf kanoniknya
+		answer.SelectedChoice, err = layout.ToCanonical(req.QuestionId, req.SelectedChoice, req.SelectedChoiceIds)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 	}
 
-	err := s.repo.SubmitAnswer(ctx, req.SessionId, answer)
+	err = s.repo.SubmitAnswer(ctx, req.SessionId, answer)
 	if err != nil {
 		switch {
 		case errors.Is(err, repository.ErrSessionNotFound):
 			return nil, status.Error(codes.NotFound, "session not found")
 		case errors.Is(err, repository.ErrInvalidSessionState):
 			return nil, status.Error(codes.FailedPrecondition, "session is not in valid state for answering")
+		case errors.Is(err, repository.ErrSessionExpired):
+			return nil, status.Error(codes.FailedPrecondition, "session time has expired")
+		case errors.Is(err, repository.ErrQuestionNotInSession):
+			return nil, status.Error(codes.InvalidArgument, "question is not part of this session")
 		default:
 			return nil, status.Errorf(codes.Internal, "failed to submit answer: %v", err)
 		}
@@ -110,18 +173,25 @@ func (s *sessionService) FinishSession(ctx context.Context, req *sessionv1.Finis
 		return nil, status.Errorf(codes.Internal, "failed to get session: %v", err)
 	}
 
-	if session.Status == domain.SessionStatusFinished {
+	if session.Status == domain.SessionStatusFinished || session.Status == domain.SessionStatusTimeout {
 		return nil, status.Error(codes.FailedPrecondition, "session is already finished")
 	}
 
 	err = s.repo.FinishSession(ctx, req.Id)
 	if err != nil {
+		if errors.Is(err, repository.ErrInvalidSessionState) {
+			return nil, status.Error(codes.FailedPrecondition, "session is already finished")
+		}
 		return nil, status.Errorf(codes.Internal, "failed to finish session: %v", err)
 	}
 
 	session.Status = domain.SessionStatusFinished
 	session.EndTime = time.Now()
 
+	if err := s.displayAnswers(ctx, session); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to map answers: %v", err)
+	}
+
 	return convertDomainToProto(session), nil
 }
 
@@ -134,11 +204,17 @@ func (s *sessionService) GetRemainingTime(ctx context.Context, req *sessionv1.Ge
 		return nil, status.Errorf(codes.Internal, "failed to get session: %v", err)
 	}
 
-	// Mendapatkan durasi ujian dari ExamService (bisa menggunakan gRPC client)
-	// Untuk sementara kita hardcode sebagai contoh
-	const examDurationMinutes = 120
+	// Mendapatkan durasi dan waktu tutup ujian dari ExamService
+	timing, err := s.exams.Get(ctx, session.ExamID)
+	if err != nil {
+		// Status dari ExamService (misal NotFound) diteruskan apa adanya
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get exam timing: %v", err)
+	}
 
-	remaining := session.CalculateRemainingTime(examDurationMinutes)
+	remaining := session.CalculateRemainingTime(timing.DurationMins, timing.CloseTime)
 
 	return &sessionv1.GetRemainingTimeResponse{
 		RemainingMinutes: remaining.Minutes,
@@ -146,6 +222,55 @@ func (s *sessionService) GetRemainingTime(ctx context.Context, req *sessionv1.Ge
 	}, nil
 }
 
+func (s *sessionService) GrantExtraTime(ctx context.Context, req *sessionv1.GrantExtraTimeRequest) (*sessionv1.ExamSession, error) {
+	if req.Minutes <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "extra time must be a positive number of minutes")
+	}
+
+	if err := s.repo.AddExtraTime(ctx, req.SessionId, req.Minutes); err != nil {
+		if errors.Is(err, repository.ErrInvalidSessionState) {
+			return nil, status.Error(codes.FailedPrecondition, "extra time can only be granted to an active session")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to grant extra time: %v", err)
+	}
+
+	session, err := s.repo.GetSession(ctx, req.SessionId)
+	if err != nil {
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			return nil, status.Error(codes.NotFound, "session not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get session: %v", err)
+	}
+
+	return convertDomainToProto(session), nil
+}
+
+// displayAnswers mengubah jawaban kanonik yang tersimpan menjadi huruf sesuai
+// urutan pilihan yang dilihat siswa pada sesi ini
+func (s *sessionService) displayAnswers(ctx context.Context, session *domain.ExamSession) error {
+	if session.ChoiceSeed == 0 || len(session.Answers) == 0 {
+		return nil
+	}
+
+	layout, err := s.repo.GetChoiceLayout(ctx, session.ID)
+	if err != nil {
+		return err
+	}
+
+	for i, answer := range session.Answers {
+		if layout.IsFreeText(answer.QuestionID) {
+			continue
+		}
+		displayed, err := layout.ToDisplayed(answer.QuestionID, answer.SelectedChoice)
+		if err != nil {
+			return err
+		}
+		session.Answers[i].SelectedChoice = displayed
+	}
+
+	return nil
+}
+
 // Helper function untuk konversi domain ke proto
 func convertDomainToProto(session *domain.ExamSession) *sessionv1.ExamSession {
 	protoSession := &sessionv1.ExamSession{
@@ -154,6 +279,9 @@ func convertDomainToProto(session *domain.ExamSession) *sessionv1.ExamSession {
 		StudentId: session.StudentID,
 		Status:    convertStatusToProto(session.Status),
 		StartTime: timestamppb.New(session.StartTime),
+
+		ExtraTimeMinutes: session.ExtraTimeMins,
+		QuestionIds:      session.QuestionIDs,
 	}
 
 	if !session.EndTime.IsZero() {
@@ -164,6 +292,7 @@ func convertDomainToProto(session *domain.ExamSession) *sessionv1.ExamSession {
 		protoSession.Answers = append(protoSession.Answers, &sessionv1.Answer{
 			QuestionId:     answer.QuestionID,
 			SelectedChoice: answer.SelectedChoice,
+			AnswerText:     answer.AnswerText,
 			AnsweredAt:     timestamppb.New(answer.AnsweredAt),
 		})
 	}
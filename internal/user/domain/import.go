@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// MaxImportRows membatasi jumlah baris dalam satu file impor
+const MaxImportRows = 5000
+
+// importColumns memetakan nama kolom header CSV yang dikenali
+var importColumns = map[string]string{
+	"student_number": "student_number",
+	"nis":            "student_number",
+	"name":           "name",
+	"nama":           "name",
+	"email":          "email",
+	"class":          "class",
+	"kelas":          "class",
+}
+
+// StudentImportRow adalah satu baris valid dari file impor siswa
+type StudentImportRow struct {
+	Line      int32
+	Student   Student
+	ClassName string
+}
+
+type ImportError struct {
+	Line    int32  `json:"line"`
+	Message string `json:"message"`
+}
+
+// ImportResult merangkum hasil impor siswa
+type ImportResult struct {
+	Created        int32
+	Updated        int32
+	Enrolled       int32
+	ClassesCreated int32
+	Errors         []ImportError
+}
+
+// ParseStudentCSV membaca file impor siswa. Baris yang tidak valid tidak
+// menggagalkan impor, melainkan dikembalikan sebagai ImportError. Error hanya
+// dikembalikan jika file tidak dapat dibaca sama sekali.
+func ParseStudentCSV(content []byte) ([]StudentImportRow, []ImportError, error) {
+	r := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, nil, errors.New("file is empty")
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid header: %v", err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		if column, ok := importColumns[strings.ToLower(strings.TrimSpace(name))]; ok {
+			columns[column] = i
+		}
+	}
+	for _, required := range []string{"student_number", "name"} {
+		if _, ok := columns[required]; !ok {
+			return nil, nil, fmt.Errorf("missing column %q", required)
+		}
+	}
+
+	field := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var rows []StudentImportRow
+	var rowErrors []ImportError
+	// Baris pertama untuk setiap NIS, agar NIS ganda dalam file terdeteksi
+	seen := make(map[string]int32)
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, nil, err
+			}
+			rowErrors = append(rowErrors, ImportError{Line: int32(parseErr.StartLine), Message: parseErr.Err.Error()})
+			continue
+		}
+		if len(rows)+len(rowErrors) >= MaxImportRows {
+			return nil, nil, fmt.Errorf("file has more than %d rows", MaxImportRows)
+		}
+		line, _ := r.FieldPos(0)
+
+		row := StudentImportRow{
+			Line: int32(line),
+			Student: Student{
+				StudentNumber: field(record, "student_number"),
+				Name:          field(record, "name"),
+				Email:         field(record, "email"),
+			},
+			ClassName: field(record, "class"),
+		}
+		if err := row.Student.Normalize(); err != nil {
+			rowErrors = append(rowErrors, ImportError{Line: row.Line, Message: err.Error()})
+			continue
+		}
+		if first, ok := seen[row.Student.StudentNumber]; ok {
+			rowErrors = append(rowErrors, ImportError{
+				Line:    row.Line,
+				Message: fmt.Sprintf("student number %s already appears on line %d", row.Student.StudentNumber, first),
+			})
+			continue
+		}
+		seen[row.Student.StudentNumber] = row.Line
+
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 && len(rowErrors) == 0 {
+		return nil, nil, errors.New("file has no rows")
+	}
+
+	return rows, rowErrors, nil
+}
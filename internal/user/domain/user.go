@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+type Student struct {
+	ID string `json:"id"`
+	// StudentNumber adalah NIS siswa, unik
+	StudentNumber string    `json:"student_number"`
+	Name          string    `json:"name"`
+	Email         string    `json:"email"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type Teacher struct {
+	ID string `json:"id"`
+	// EmployeeNumber adalah NIP guru, unik
+	EmployeeNumber string    `json:"employee_number"`
+	Name           string    `json:"name"`
+	Email          string    `json:"email"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type Class struct {
+	ID                string    `json:"id"`
+	Name              string    `json:"name"`
+	GradeLevel        int32     `json:"grade_level"`
+	HomeroomTeacherID string    `json:"homeroom_teacher_id"`
+	StudentCount      int32     `json:"student_count"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// ClassStudent adalah siswa beserta kelas tempat ia terdaftar
+type ClassStudent struct {
+	ClassID string
+	Student Student
+}
+
+// StudentFilter membatasi ListStudents; field kosong diabaikan
+type StudentFilter struct {
+	ClassID string
+	// Search dicocokkan dengan nama atau NIS
+	Search string
+}
+
+// Normalize merapikan data siswa dan memastikan field wajib terisi
+func (s *Student) Normalize() error {
+	s.StudentNumber = strings.TrimSpace(s.StudentNumber)
+	s.Name = strings.TrimSpace(s.Name)
+	s.Email = strings.TrimSpace(s.Email)
+
+	if s.StudentNumber == "" {
+		return errors.New("student number is required")
+	}
+	if s.Name == "" {
+		return errors.New("name is required")
+	}
+	return nil
+}
+
+// Normalize merapikan data guru dan memastikan field wajib terisi
+func (t *Teacher) Normalize() error {
+	t.EmployeeNumber = strings.TrimSpace(t.EmployeeNumber)
+	t.Name = strings.TrimSpace(t.Name)
+	t.Email = strings.TrimSpace(t.Email)
+
+	if t.EmployeeNumber == "" {
+		return errors.New("employee number is required")
+	}
+	if t.Name == "" {
+		return errors.New("name is required")
+	}
+	return nil
+}
+
+// Normalize merapikan data kelas dan memastikan field wajib terisi
+func (c *Class) Normalize() error {
+	c.Name = strings.TrimSpace(c.Name)
+	c.HomeroomTeacherID = strings.TrimSpace(c.HomeroomTeacherID)
+
+	if c.Name == "" {
+		return errors.New("class name is required")
+	}
+	if c.GradeLevel < 0 {
+		return errors.New("grade level must not be negative")
+	}
+	return nil
+}
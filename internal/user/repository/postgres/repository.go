@@ -0,0 +1,608 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/ApesJs/cbt-exam/internal/user/domain"
+	"github.com/ApesJs/cbt-exam/internal/user/repository"
+	"github.com/ApesJs/cbt-exam/pkg/pagination"
+)
+
+type postgresRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresRepository(db *sql.DB) repository.UserRepository {
+	return &postgresRepository{
+		db: db,
+	}
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// isViolation melaporkan apakah err adalah pelanggaran constraint dengan kode code
+// (23505 unique_violation, 23503 foreign_key_violation)
+func isViolation(err error, code pq.ErrorCode) bool {
+	pqErr, ok := errors.Cause(err).(*pq.Error)
+	return ok && pqErr.Code == code
+}
+
+const (
+	uniqueViolation     pq.ErrorCode = "23505"
+	foreignKeyViolation pq.ErrorCode = "23503"
+)
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+const studentColumns = `s.id, s.student_number, s.name, s.email, s.created_at, s.updated_at`
+
+func scanStudent(row rowScanner, prefix ...interface{}) (*domain.Student, error) {
+	student := &domain.Student{}
+	dest := append(prefix,
+		&student.ID,
+		&student.StudentNumber,
+		&student.Name,
+		&student.Email,
+		&student.CreatedAt,
+		&student.UpdatedAt,
+	)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	return student, nil
+}
+
+func (r *postgresRepository) CreateStudent(ctx context.Context, student *domain.Student) error {
+	query := `
+        INSERT INTO students (student_number, name, email)
+        VALUES ($1, $2, $3)
+        RETURNING id, created_at, updated_at`
+
+	err := r.db.QueryRowContext(ctx, query, student.StudentNumber, student.Name, student.Email).
+		Scan(&student.ID, &student.CreatedAt, &student.UpdatedAt)
+	if isViolation(err, uniqueViolation) {
+		return repository.ErrDuplicateStudentNumber
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to insert student")
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) GetStudent(ctx context.Context, id string) (*domain.Student, error) {
+	student, err := scanStudent(r.db.QueryRowContext(ctx,
+		"SELECT "+studentColumns+" FROM students s WHERE s.id = $1", id))
+	if err == sql.ErrNoRows {
+		return nil, repository.ErrStudentNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get student")
+	}
+
+	return student, nil
+}
+
+// studentConditions menyusun klausa WHERE untuk filter beserta argumennya
+func studentConditions(filter domain.StudentFilter) ([]string, []interface{}) {
+	var conds []string
+	var args []interface{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter.ClassID != "" {
+		conds = append(conds, "EXISTS (SELECT 1 FROM class_students cs WHERE cs.student_id = s.id AND cs.class_id = "+arg(filter.ClassID)+")")
+	}
+	if filter.Search != "" {
+		pattern := arg(likeEscaper.Replace(filter.Search))
+		conds = append(conds, "(s.name ILIKE '%' || "+pattern+" || '%' OR s.student_number ILIKE '%' || "+pattern+" || '%')")
+	}
+
+	return conds, args
+}
+
+func whereClause(conds []string) string {
+	if len(conds) == 0 {
+		return ""
+	}
+	return "\n        WHERE " + strings.Join(conds, "\n          AND ")
+}
+
+// ListStudents mengembalikan siswa sesuai urutan nama, mulai setelah cursor after
+func (r *postgresRepository) ListStudents(ctx context.Context, filter domain.StudentFilter, limit int32, after *pagination.Cursor) ([]*domain.Student, error) {
+	conds, args := studentConditions(filter)
+	if after != nil {
+		args = append(args, after.Value, after.ID)
+		conds = append(conds, fmt.Sprintf("(s.name, s.id) > ($%d, $%d::uuid)", len(args)-1, len(args)))
+	}
+	args = append(args, limit)
+
+	query := "SELECT " + studentColumns + " FROM students s" + whereClause(conds) + fmt.Sprintf(`
+        ORDER BY s.name, s.id
+        LIMIT $%d`, len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list students")
+	}
+	defer rows.Close()
+
+	var students []*domain.Student
+	for rows.Next() {
+		student, err := scanStudent(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan student")
+		}
+		students = append(students, student)
+	}
+
+	return students, rows.Err()
+}
+
+func (r *postgresRepository) CountStudents(ctx context.Context, filter domain.StudentFilter) (int32, error) {
+	conds, args := studentConditions(filter)
+
+	var count int32
+	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM students s"+whereClause(conds), args...).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to count students")
+	}
+
+	return count, nil
+}
+
+func (r *postgresRepository) UpdateStudent(ctx context.Context, student *domain.Student) error {
+	query := `
+        UPDATE students
+        SET student_number = $1, name = $2, email = $3, updated_at = CURRENT_TIMESTAMP
+        WHERE id = $4
+        RETURNING created_at, updated_at`
+
+	err := r.db.QueryRowContext(ctx, query, student.StudentNumber, student.Name, student.Email, student.ID).
+		Scan(&student.CreatedAt, &student.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return repository.ErrStudentNotFound
+	}
+	if isViolation(err, uniqueViolation) {
+		return repository.ErrDuplicateStudentNumber
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to update student")
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) DeleteStudent(ctx context.Context, id string) error {
+	return r.delete(ctx, "students", id, repository.ErrStudentNotFound)
+}
+
+// delete menghapus satu baris berdasarkan id dan mengembalikan notFound jika tidak ada
+func (r *postgresRepository) delete(ctx context.Context, table, id string, notFound error) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM "+table+" WHERE id = $1", id)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete from %s", table)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return notFound
+	}
+
+	return nil
+}
+
+const teacherColumns = `t.id, t.employee_number, t.name, t.email, t.created_at, t.updated_at`
+
+func scanTeacher(row rowScanner) (*domain.Teacher, error) {
+	teacher := &domain.Teacher{}
+	err := row.Scan(
+		&teacher.ID,
+		&teacher.EmployeeNumber,
+		&teacher.Name,
+		&teacher.Email,
+		&teacher.CreatedAt,
+		&teacher.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return teacher, nil
+}
+
+func (r *postgresRepository) CreateTeacher(ctx context.Context, teacher *domain.Teacher) error {
+	query := `
+        INSERT INTO teachers (employee_number, name, email)
+        VALUES ($1, $2, $3)
+        RETURNING id, created_at, updated_at`
+
+	err := r.db.QueryRowContext(ctx, query, teacher.EmployeeNumber, teacher.Name, teacher.Email).
+		Scan(&teacher.ID, &teacher.CreatedAt, &teacher.UpdatedAt)
+	if isViolation(err, uniqueViolation) {
+		return repository.ErrDuplicateEmployeeNumber
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to insert teacher")
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) GetTeacher(ctx context.Context, id string) (*domain.Teacher, error) {
+	teacher, err := scanTeacher(r.db.QueryRowContext(ctx,
+		"SELECT "+teacherColumns+" FROM teachers t WHERE t.id = $1", id))
+	if err == sql.ErrNoRows {
+		return nil, repository.ErrTeacherNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get teacher")
+	}
+
+	return teacher, nil
+}
+
+// ListTeachers mengembalikan guru sesuai urutan nama, mulai setelah cursor after
+func (r *postgresRepository) ListTeachers(ctx context.Context, limit int32, after *pagination.Cursor) ([]*domain.Teacher, error) {
+	query := "SELECT " + teacherColumns + ` FROM teachers t
+        WHERE ($1::text IS NULL OR (t.name, t.id) > ($1, $2::uuid))
+        ORDER BY t.name, t.id
+        LIMIT $3`
+
+	var afterName, afterID interface{}
+	if after != nil {
+		afterName, afterID = after.Value, after.ID
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, afterName, afterID, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list teachers")
+	}
+	defer rows.Close()
+
+	var teachers []*domain.Teacher
+	for rows.Next() {
+		teacher, err := scanTeacher(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan teacher")
+		}
+		teachers = append(teachers, teacher)
+	}
+
+	return teachers, rows.Err()
+}
+
+func (r *postgresRepository) CountTeachers(ctx context.Context) (int32, error) {
+	var count int32
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM teachers").Scan(&count); err != nil {
+		return 0, errors.Wrap(err, "failed to count teachers")
+	}
+
+	return count, nil
+}
+
+func (r *postgresRepository) UpdateTeacher(ctx context.Context, teacher *domain.Teacher) error {
+	query := `
+        UPDATE teachers
+        SET employee_number = $1, name = $2, email = $3, updated_at = CURRENT_TIMESTAMP
+        WHERE id = $4
+        RETURNING created_at, updated_at`
+
+	err := r.db.QueryRowContext(ctx, query, teacher.EmployeeNumber, teacher.Name, teacher.Email, teacher.ID).
+		Scan(&teacher.CreatedAt, &teacher.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return repository.ErrTeacherNotFound
+	}
+	if isViolation(err, uniqueViolation) {
+		return repository.ErrDuplicateEmployeeNumber
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to update teacher")
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) DeleteTeacher(ctx context.Context, id string) error {
+	return r.delete(ctx, "teachers", id, repository.ErrTeacherNotFound)
+}
+
+const classQuery = `
+        SELECT c.id, c.name, c.grade_level, COALESCE(c.homeroom_teacher_id::text, ''),
+               (SELECT COUNT(*) FROM class_students cs WHERE cs.class_id = c.id),
+               c.created_at, c.updated_at
+        FROM classes c`
+
+func scanClass(row rowScanner) (*domain.Class, error) {
+	class := &domain.Class{}
+	err := row.Scan(
+		&class.ID,
+		&class.Name,
+		&class.GradeLevel,
+		&class.HomeroomTeacherID,
+		&class.StudentCount,
+		&class.CreatedAt,
+		&class.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return class, nil
+}
+
+// classError menerjemahkan pelanggaran constraint saat menulis kelas
+func classError(err error, action string) error {
+	switch {
+	case isViolation(err, uniqueViolation):
+		return repository.ErrDuplicateClassName
+	case isViolation(err, foreignKeyViolation):
+		return repository.ErrTeacherNotFound
+	default:
+		return errors.Wrap(err, action)
+	}
+}
+
+func (r *postgresRepository) CreateClass(ctx context.Context, class *domain.Class) error {
+	query := `
+        INSERT INTO classes (name, grade_level, homeroom_teacher_id)
+        VALUES ($1, $2, $3)
+        RETURNING id, created_at, updated_at`
+
+	err := r.db.QueryRowContext(ctx, query, class.Name, class.GradeLevel, nullString(class.HomeroomTeacherID)).
+		Scan(&class.ID, &class.CreatedAt, &class.UpdatedAt)
+	if err != nil {
+		return classError(err, "failed to insert class")
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) GetClass(ctx context.Context, id string) (*domain.Class, error) {
+	class, err := scanClass(r.db.QueryRowContext(ctx, classQuery+" WHERE c.id = $1", id))
+	if err == sql.ErrNoRows {
+		return nil, repository.ErrClassNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get class")
+	}
+
+	return class, nil
+}
+
+// ListClasses mengembalikan kelas sesuai urutan nama, mulai setelah cursor after
+func (r *postgresRepository) ListClasses(ctx context.Context, limit int32, after *pagination.Cursor) ([]*domain.Class, error) {
+	query := classQuery + `
+        WHERE ($1::text IS NULL OR (c.name, c.id) > ($1, $2::uuid))
+        ORDER BY c.name, c.id
+        LIMIT $3`
+
+	var afterName, afterID interface{}
+	if after != nil {
+		afterName, afterID = after.Value, after.ID
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, afterName, afterID, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list classes")
+	}
+	defer rows.Close()
+
+	var classes []*domain.Class
+	for rows.Next() {
+		class, err := scanClass(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan class")
+		}
+		classes = append(classes, class)
+	}
+
+	return classes, rows.Err()
+}
+
+func (r *postgresRepository) CountClasses(ctx context.Context) (int32, error) {
+	var count int32
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM classes").Scan(&count); err != nil {
+		return 0, errors.Wrap(err, "failed to count classes")
+	}
+
+	return count, nil
+}
+
+func (r *postgresRepository) UpdateClass(ctx context.Context, class *domain.Class) error {
+	query := `
+        UPDATE classes
+        SET name = $1, grade_level = $2, homeroom_teacher_id = $3, updated_at = CURRENT_TIMESTAMP
+        WHERE id = $4
+        RETURNING created_at, updated_at,
+                  (SELECT COUNT(*) FROM class_students cs WHERE cs.class_id = classes.id)`
+
+	err := r.db.QueryRowContext(ctx, query, class.Name, class.GradeLevel, nullString(class.HomeroomTeacherID), class.ID).
+		Scan(&class.CreatedAt, &class.UpdatedAt, &class.StudentCount)
+	if err == sql.ErrNoRows {
+		return repository.ErrClassNotFound
+	}
+	if err != nil {
+		return classError(err, "failed to update class")
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) DeleteClass(ctx context.Context, id string) error {
+	return r.delete(ctx, "classes", id, repository.ErrClassNotFound)
+}
+
+func (r *postgresRepository) EnrollStudents(ctx context.Context, classID string, studentIDs []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	err = tx.QueryRowContext(ctx, "SELECT true FROM classes WHERE id = $1 FOR SHARE", classID).Scan(&exists)
+	if err == sql.ErrNoRows {
+		return repository.ErrClassNotFound
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to lock class")
+	}
+
+	// Siswa yang sudah terdaftar diabaikan
+	_, err = tx.ExecContext(ctx, `
+        INSERT INTO class_students (class_id, student_id)
+        SELECT $1, unnest($2::uuid[])
+        ON CONFLICT DO NOTHING`,
+		classID, pq.Array(studentIDs),
+	)
+	if isViolation(err, foreignKeyViolation) {
+		return repository.ErrStudentNotFound
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to enroll students")
+	}
+
+	return tx.Commit()
+}
+
+func (r *postgresRepository) UnenrollStudent(ctx context.Context, classID, studentID string) error {
+	result, err := r.db.ExecContext(ctx,
+		"DELETE FROM class_students WHERE class_id = $1 AND student_id = $2",
+		classID, studentID,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to unenroll student")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrNotEnrolled
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) ListClassStudents(ctx context.Context, classIDs []string) ([]*domain.ClassStudent, error) {
+	query := `
+        SELECT cs.class_id, ` + studentColumns + `
+        FROM class_students cs
+        JOIN students s ON s.id = cs.student_id
+        WHERE cs.class_id = ANY($1::uuid[])
+        ORDER BY cs.class_id, s.name, s.id`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(classIDs))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list class students")
+	}
+	defer rows.Close()
+
+	var students []*domain.ClassStudent
+	for rows.Next() {
+		var classID string
+		student, err := scanStudent(rows, &classID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan class student")
+		}
+		students = append(students, &domain.ClassStudent{ClassID: classID, Student: *student})
+	}
+
+	return students, rows.Err()
+}
+
+// ImportStudents menyimpan semua baris dalam satu transaksi. Siswa dicocokkan
+// berdasarkan NIS; kelas yang belum ada dibuat dari namanya.
+func (r *postgresRepository) ImportStudents(ctx context.Context, rows []domain.StudentImportRow) (*domain.ImportResult, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	result := &domain.ImportResult{}
+	classIDs := make(map[string]string)
+	for _, row := range rows {
+		// xmax = 0 hanya untuk baris yang baru dimasukkan. Email kosong di CSV
+		// tidak menghapus email yang sudah tersimpan.
+		var studentID string
+		var inserted bool
+		err := tx.QueryRowContext(ctx, `
+            INSERT INTO students (student_number, name, email)
+            VALUES ($1, $2, $3)
+            ON CONFLICT (student_number) DO UPDATE
+            SET name = EXCLUDED.name,
+                email = COALESCE(NULLIF(EXCLUDED.email, ''), students.email),
+                updated_at = CURRENT_TIMESTAMP
+            RETURNING id, xmax = 0`,
+			row.Student.StudentNumber, row.Student.Name, row.Student.Email,
+		).Scan(&studentID, &inserted)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to import student on line %d", row.Line)
+		}
+		if inserted {
+			result.Created++
+		} else {
+			result.Updated++
+		}
+
+		if row.ClassName == "" {
+			continue
+		}
+
+		classID, ok := classIDs[row.ClassName]
+		if !ok {
+			var created bool
+			err := tx.QueryRowContext(ctx, `
+                INSERT INTO classes (name)
+                VALUES ($1)
+                ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+                RETURNING id, xmax = 0`,
+				row.ClassName,
+			).Scan(&classID, &created)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to import class on line %d", row.Line)
+			}
+			if created {
+				result.ClassesCreated++
+			}
+			classIDs[row.ClassName] = classID
+		}
+
+		res, err := tx.ExecContext(ctx,
+			"INSERT INTO class_students (class_id, student_id) VALUES ($1, $2) ON CONFLICT DO NOTHING",
+			classID, studentID,
+		)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to enroll student on line %d", row.Line)
+		}
+		if n, _ := res.RowsAffected(); n > 0 {
+			result.Enrolled++
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ApesJs/cbt-exam/internal/user/domain"
+	"github.com/ApesJs/cbt-exam/pkg/pagination"
+)
+
+type UserRepository interface {
+	// Student operations
+	CreateStudent(ctx context.Context, student *domain.Student) error
+	GetStudent(ctx context.Context, id string) (*domain.Student, error)
+	ListStudents(ctx context.Context, filter domain.StudentFilter, limit int32, after *pagination.Cursor) ([]*domain.Student, error)
+	CountStudents(ctx context.Context, filter domain.StudentFilter) (int32, error)
+	UpdateStudent(ctx context.Context, student *domain.Student) error
+	DeleteStudent(ctx context.Context, id string) error
+
+	// Teacher operations
+	CreateTeacher(ctx context.Context, teacher *domain.Teacher) error
+	GetTeacher(ctx context.Context, id string) (*domain.Teacher, error)
+	ListTeachers(ctx context.Context, limit int32, after *pagination.Cursor) ([]*domain.Teacher, error)
+	CountTeachers(ctx context.Context) (int32, error)
+	UpdateTeacher(ctx context.Context, teacher *domain.Teacher) error
+	DeleteTeacher(ctx context.Context, id string) error
+
+	// Class operations
+	CreateClass(ctx context.Context, class *domain.Class) error
+	GetClass(ctx context.Context, id string) (*domain.Class, error)
+	ListClasses(ctx context.Context, limit int32, after *pagination.Cursor) ([]*domain.Class, error)
+	CountClasses(ctx context.Context) (int32, error)
+	UpdateClass(ctx context.Context, class *domain.Class) error
+	DeleteClass(ctx context.Context, id string) error
+
+	// Enrollment operations
+	EnrollStudents(ctx context.Context, classID string, studentIDs []string) error
+	UnenrollStudent(ctx context.Context, classID, studentID string) error
+	ListClassStudents(ctx context.Context, classIDs []string) ([]*domain.ClassStudent, error)
+
+	// Bulk operations
+	ImportStudents(ctx context.Context, rows []domain.StudentImportRow) (*domain.ImportResult, error)
+}
+
+// Errors
+var (
+	ErrStudentNotFound         = errors.New("student not found")
+	ErrTeacherNotFound         = errors.New("teacher not found")
+	ErrClassNotFound           = errors.New("class not found")
+	ErrNotEnrolled             = errors.New("student is not enrolled in this class")
+	ErrDuplicateStudentNumber  = errors.New("student number already exists")
+	ErrDuplicateEmployeeNumber = errors.New("employee number already exists")
+	ErrDuplicateClassName      = errors.New("class name already exists")
+)
@@ -0,0 +1,437 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	userv1 "github.com/ApesJs/cbt-exam/api/proto/user/v1"
+	"github.com/ApesJs/cbt-exam/internal/user/domain"
+	"github.com/ApesJs/cbt-exam/internal/user/repository"
+	"github.com/ApesJs/cbt-exam/pkg/pagination"
+)
+
+type userService struct {
+	repo repository.UserRepository
+	userv1.UnimplementedUserServiceServer
+}
+
+func NewUserService(repo repository.UserRepository) userv1.UserServiceServer {
+	return &userService{
+		repo: repo,
+	}
+}
+
+// repoError memetakan error repository ke status gRPC
+func repoError(err error, action string) error {
+	switch {
+	case errors.Is(err, repository.ErrStudentNotFound),
+		errors.Is(err, repository.ErrTeacherNotFound),
+		errors.Is(err, repository.ErrClassNotFound),
+		errors.Is(err, repository.ErrNotEnrolled):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, repository.ErrDuplicateStudentNumber),
+		errors.Is(err, repository.ErrDuplicateEmployeeNumber),
+		errors.Is(err, repository.ErrDuplicateClassName):
+		return status.Error(codes.AlreadyExists, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+	}
+}
+
+func (s *userService) CreateStudent(ctx context.Context, req *userv1.CreateStudentRequest) (*userv1.Student, error) {
+	student := &domain.Student{
+		StudentNumber: req.StudentNumber,
+		Name:          req.Name,
+		Email:         req.Email,
+	}
+
+	if err := student.Normalize(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.repo.CreateStudent(ctx, student); err != nil {
+		return nil, repoError(err, "create student")
+	}
+
+	return convertStudentToProto(student), nil
+}
+
+func (s *userService) GetStudent(ctx context.Context, req *userv1.GetStudentRequest) (*userv1.Student, error) {
+	student, err := s.repo.GetStudent(ctx, req.Id)
+	if err != nil {
+		return nil, repoError(err, "get student")
+	}
+
+	return convertStudentToProto(student), nil
+}
+
+func (s *userService) ListStudents(ctx context.Context, req *userv1.ListStudentsRequest) (*userv1.ListStudentsResponse, error) {
+	after, err := pagination.Decode(req.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := domain.StudentFilter{
+		ClassID: req.ClassId,
+		Search:  strings.TrimSpace(req.Query),
+	}
+
+	// Ambil satu baris lebih untuk mengetahui apakah masih ada halaman berikutnya
+	pageSize := pagination.PageSize(req.PageSize)
+	students, err := s.repo.ListStudents(ctx, filter, pageSize+1, after)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list students: %v", err)
+	}
+
+	resp := &userv1.ListStudentsResponse{}
+	if len(students) > int(pageSize) {
+		students = students[:pageSize]
+		last := students[len(students)-1]
+		resp.NextPageToken = pagination.Encode(pagination.Cursor{Value: last.Name, ID: last.ID})
+	}
+	for _, student := range students {
+		resp.Students = append(resp.Students, convertStudentToProto(student))
+	}
+
+	if req.IncludeTotal {
+		resp.TotalSize, err = s.repo.CountStudents(ctx, filter)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to count students: %v", err)
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *userService) UpdateStudent(ctx context.Context, req *userv1.UpdateStudentRequest) (*userv1.Student, error) {
+	if req.Student == nil {
+		return nil, status.Error(codes.InvalidArgument, "student is required")
+	}
+
+	student := &domain.Student{
+		ID:            req.Id,
+		StudentNumber: req.Student.StudentNumber,
+		Name:          req.Student.Name,
+		Email:         req.Student.Email,
+	}
+
+	if err := student.Normalize(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.repo.UpdateStudent(ctx, student); err != nil {
+		return nil, repoError(err, "update student")
+	}
+
+	return convertStudentToProto(student), nil
+}
+
+func (s *userService) DeleteStudent(ctx context.Context, req *userv1.DeleteStudentRequest) (*emptypb.Empty, error) {
+	if err := s.repo.DeleteStudent(ctx, req.Id); err != nil {
+		return nil, repoError(err, "delete student")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *userService) CreateTeacher(ctx context.Context, req *userv1.CreateTeacherRequest) (*userv1.Teacher, error) {
+	teacher := &domain.Teacher{
+		EmployeeNumber: req.EmployeeNumber,
+		Name:           req.Name,
+		Email:          req.Email,
+	}
+
+	if err := teacher.Normalize(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.repo.CreateTeacher(ctx, teacher); err != nil {
+		return nil, repoError(err, "create teacher")
+	}
+
+	return convertTeacherToProto(teacher), nil
+}
+
+func (s *userService) GetTeacher(ctx context.Context, req *userv1.GetTeacherRequest) (*userv1.Teacher, error) {
+	teacher, err := s.repo.GetTeacher(ctx, req.Id)
+	if err != nil {
+		return nil, repoError(err, "get teacher")
+	}
+
+	return convertTeacherToProto(teacher), nil
+}
+
+func (s *userService) ListTeachers(ctx context.Context, req *userv1.ListTeachersRequest) (*userv1.ListTeachersResponse, error) {
+	after, err := pagination.Decode(req.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Ambil satu baris lebih untuk mengetahui apakah masih ada halaman berikutnya
+	pageSize := pagination.PageSize(req.PageSize)
+	teachers, err := s.repo.ListTeachers(ctx, pageSize+1, after)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list teachers: %v", err)
+	}
+
+	resp := &userv1.ListTeachersResponse{}
+	if len(teachers) > int(pageSize) {
+		teachers = teachers[:pageSize]
+		last := teachers[len(teachers)-1]
+		resp.NextPageToken = pagination.Encode(pagination.Cursor{Value: last.Name, ID: last.ID})
+	}
+	for _, teacher := range teachers {
+		resp.Teachers = append(resp.Teachers, convertTeacherToProto(teacher))
+	}
+
+	if req.IncludeTotal {
+		resp.TotalSize, err = s.repo.CountTeachers(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to count teachers: %v", err)
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *userService) UpdateTeacher(ctx context.Context, req *userv1.UpdateTeacherRequest) (*userv1.Teacher, error) {
+	if req.Teacher == nil {
+		return nil, status.Error(codes.InvalidArgument, "teacher is required")
+	}
+
+	teacher := &domain.Teacher{
+		ID:             req.Id,
+		EmployeeNumber: req.Teacher.EmployeeNumber,
+		Name:           req.Teacher.Name,
+		Email:          req.Teacher.Email,
+	}
+
+	if err := teacher.Normalize(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.repo.UpdateTeacher(ctx, teacher); err != nil {
+		return nil, repoError(err, "update teacher")
+	}
+
+	return convertTeacherToProto(teacher), nil
+}
+
+func (s *userService) DeleteTeacher(ctx context.Context, req *userv1.DeleteTeacherRequest) (*emptypb.Empty, error) {
+	if err := s.repo.DeleteTeacher(ctx, req.Id); err != nil {
+		return nil, repoError(err, "delete teacher")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *userService) CreateClass(ctx context.Context, req *userv1.CreateClassRequest) (*userv1.Class, error) {
+	class := &domain.Class{
+		Name:              req.Name,
+		GradeLevel:        req.GradeLevel,
+		HomeroomTeacherID: req.HomeroomTeacherId,
+	}
+
+	if err := class.Normalize(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.repo.CreateClass(ctx, class); err != nil {
+		return nil, repoError(err, "create class")
+	}
+
+	return convertClassToProto(class), nil
+}
+
+func (s *userService) GetClass(ctx context.Context, req *userv1.GetClassRequest) (*userv1.Class, error) {
+	class, err := s.repo.GetClass(ctx, req.Id)
+	if err != nil {
+		return nil, repoError(err, "get class")
+	}
+
+	return convertClassToProto(class), nil
+}
+
+func (s *userService) ListClasses(ctx context.Context, req *userv1.ListClassesRequest) (*userv1.ListClassesResponse, error) {
+	after, err := pagination.Decode(req.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Ambil satu baris lebih untuk mengetahui apakah masih ada halaman berikutnya
+	pageSize := pagination.PageSize(req.PageSize)
+	classes, err := s.repo.ListClasses(ctx, pageSize+1, after)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list classes: %v", err)
+	}
+
+	resp := &userv1.ListClassesResponse{}
+	if len(classes) > int(pageSize) {
+		classes = classes[:pageSize]
+		last := classes[len(classes)-1]
+		resp.NextPageToken = pagination.Encode(pagination.Cursor{Value: last.Name, ID: last.ID})
+	}
+	for _, class := range classes {
+		resp.Classes = append(resp.Classes, convertClassToProto(class))
+	}
+
+	if req.IncludeTotal {
+		resp.TotalSize, err = s.repo.CountClasses(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to count classes: %v", err)
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *userService) UpdateClass(ctx context.Context, req *userv1.UpdateClassRequest) (*userv1.Class, error) {
+	if req.Class == nil {
+		return nil, status.Error(codes.InvalidArgument, "class is required")
+	}
+
+	class := &domain.Class{
+		ID:                req.Id,
+		Name:              req.Class.Name,
+		GradeLevel:        req.Class.GradeLevel,
+		HomeroomTeacherID: req.Class.HomeroomTeacherId,
+	}
+
+	if err := class.Normalize(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.repo.UpdateClass(ctx, class); err != nil {
+		return nil, repoError(err, "update class")
+	}
+
+	return convertClassToProto(class), nil
+}
+
+func (s *userService) DeleteClass(ctx context.Context, req *userv1.DeleteClassRequest) (*emptypb.Empty, error) {
+	if err := s.repo.DeleteClass(ctx, req.Id); err != nil {
+		return nil, repoError(err, "delete class")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *userService) EnrollStudents(ctx context.Context, req *userv1.EnrollStudentsRequest) (*emptypb.Empty, error) {
+	if len(req.StudentIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "student_ids is required")
+	}
+
+	if err := s.repo.EnrollStudents(ctx, req.ClassId, req.StudentIds); err != nil {
+		return nil, repoError(err, "enroll students")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *userService) UnenrollStudent(ctx context.Context, req *userv1.UnenrollStudentRequest) (*emptypb.Empty, error) {
+	if err := s.repo.UnenrollStudent(ctx, req.ClassId, req.StudentId); err != nil {
+		return nil, repoError(err, "unenroll student")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *userService) ListClassStudents(ctx context.Context, req *userv1.ListClassStudentsRequest) (*userv1.ListClassStudentsResponse, error) {
+	resp := &userv1.ListClassStudentsResponse{}
+	if len(req.ClassIds) == 0 {
+		return resp, nil
+	}
+
+	students, err := s.repo.ListClassStudents(ctx, req.ClassIds)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list class students: %v", err)
+	}
+
+	for _, cs := range students {
+		resp.Students = append(resp.Students, &userv1.ClassStudent{
+			ClassId: cs.ClassID,
+			Student: convertStudentToProto(&cs.Student),
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *userService) ImportStudents(ctx context.Context, req *userv1.ImportStudentsRequest) (*userv1.ImportStudentsResponse, error) {
+	rows, rowErrors, err := domain.ParseStudentCSV(req.Content)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result := &domain.ImportResult{}
+	if len(rows) > 0 {
+		result, err = s.repo.ImportStudents(ctx, rows)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to import students: %v", err)
+		}
+	}
+
+	resp := &userv1.ImportStudentsResponse{
+		Created:        result.Created,
+		Updated:        result.Updated,
+		Enrolled:       result.Enrolled,
+		ClassesCreated: result.ClassesCreated,
+	}
+	for _, e := range rowErrors {
+		resp.Errors = append(resp.Errors, &userv1.ImportError{
+			Line:    e.Line,
+			Message: e.Message,
+		})
+	}
+
+	return resp, nil
+}
+
+// Helper functions for converting between domain and proto
+func convertStudentToProto(student *domain.Student) *userv1.Student {
+	return &userv1.Student{
+		Id:            student.ID,
+		StudentNumber: student.StudentNumber,
+		Name:          student.Name,
+		Email:         student.Email,
+		CreatedAt:     timestamp(student.CreatedAt),
+		UpdatedAt:     timestamp(student.UpdatedAt),
+	}
+}
+
+func convertTeacherToProto(teacher *domain.Teacher) *userv1.Teacher {
+	return &userv1.Teacher{
+		Id:             teacher.ID,
+		EmployeeNumber: teacher.EmployeeNumber,
+		Name:           teacher.Name,
+		Email:          teacher.Email,
+		CreatedAt:      timestamp(teacher.CreatedAt),
+		UpdatedAt:      timestamp(teacher.UpdatedAt),
+	}
+}
+
+func convertClassToProto(class *domain.Class) *userv1.Class {
+	return &userv1.Class{
+		Id:                class.ID,
+		Name:              class.Name,
+		GradeLevel:        class.GradeLevel,
+		HomeroomTeacherId: class.HomeroomTeacherID,
+		StudentCount:      class.StudentCount,
+		CreatedAt:         timestamp(class.CreatedAt),
+		UpdatedAt:         timestamp(class.UpdatedAt),
+	}
+}
+
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,30 @@
+// Package choiceset mengelola himpunan huruf pilihan yang disimpan sebagai teks
+// dipisah koma (misal "A,C"). Dipakai untuk kunci jawaban dan jawaban siswa
+// pada soal pilihan ganda kompleks.
+package choiceset
+
+import (
+	"sort"
+	"strings"
+)
+
+// Parse memecah teks menjadi huruf pilihan dalam huruf besar, tanpa duplikat dan terurut
+func Parse(s string) []string {
+	seen := make(map[string]bool)
+	var letters []string
+	for _, part := range strings.Split(s, ",") {
+		letter := strings.ToUpper(strings.TrimSpace(part))
+		if letter == "" || seen[letter] {
+			continue
+		}
+		seen[letter] = true
+		letters = append(letters, letter)
+	}
+	sort.Strings(letters)
+	return letters
+}
+
+// Format menggabungkan huruf pilihan menjadi bentuk tersimpan yang kanonik
+func Format(letters []string) string {
+	return strings.Join(Parse(strings.Join(letters, ",")), ",")
+}
@@ -11,6 +11,7 @@ import (
 	questionv1 "github.com/ApesJs/cbt-exam/api/proto/question/v1"
 	scoringv1 "github.com/ApesJs/cbt-exam/api/proto/scoring/v1"
 	sessionv1 "github.com/ApesJs/cbt-exam/api/proto/session/v1"
+	userv1 "github.com/ApesJs/cbt-exam/api/proto/user/v1"
 )
 
 type ServiceClient struct {
@@ -18,9 +19,10 @@ type ServiceClient struct {
 	questionClient questionv1.QuestionServiceClient
 	sessionClient  sessionv1.SessionServiceClient
 	scoringClient  scoringv1.ScoringServiceClient
+	userClient     userv1.UserServiceClient
 }
 
-func NewServiceClient(examPort, questionPort, sessionPort, scoringPort int) (*ServiceClient, error) {
+func NewServiceClient(examPort, questionPort, sessionPort, scoringPort, userPort int) (*ServiceClient, error) {
 	// Connect to ExamService
 	examConn, err := grpc.Dial(fmt.Sprintf("localhost:%d", examPort),
 		grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -49,11 +51,19 @@ func NewServiceClient(examPort, questionPort, sessionPort, scoringPort int) (*Se
 		return nil, fmt.Errorf("failed to connect to scoring service: %v", err)
 	}
 
+	// Connect to UserService
+	userConn, err := grpc.Dial(fmt.Sprintf("localhost:%d", userPort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to user service: %v", err)
+	}
+
 	return &ServiceClient{
 		examClient:     examv1.NewExamServiceClient(examConn),
 		questionClient: questionv1.NewQuestionServiceClient(questionConn),
 		sessionClient:  sessionv1.NewSessionServiceClient(sessionConn),
 		scoringClient:  scoringv1.NewScoringServiceClient(scoringConn),
+		userClient:     userv1.NewUserServiceClient(userConn),
 	}, nil
 }
 
@@ -91,6 +101,12 @@ func (c *ServiceClient) DeactivateExam(ctx context.Context, req *examv1.Deactiva
 	return c.examClient.DeactivateExam(ctx, req)
 }
 
+func (c *ServiceClient) GetExamStatus(ctx context.Context, examID string) (*examv1.ExamStatus, error) {
+	return c.examClient.GetExamStatus(ctx, &examv1.GetExamStatusRequest{
+		Id: examID,
+	})
+}
+
 func (c *ServiceClient) IsExamActive(ctx context.Context, examID string) (bool, error) {
 	exam, err := c.GetExam(ctx, examID)
 	if err != nil {
@@ -105,6 +121,10 @@ func (c *ServiceClient) GetExamQuestions(ctx context.Context, req *questionv1.Ge
 	return c.questionClient.GetExamQuestions(ctx, req)
 }
 
+func (c *ServiceClient) GetSessionQuestions(ctx context.Context, req *questionv1.GetSessionQuestionsRequest) (*questionv1.GetSessionQuestionsResponse, error) {
+	return c.questionClient.GetSessionQuestions(ctx, req)
+}
+
 func (c *ServiceClient) GetSession(ctx context.Context, req *sessionv1.GetSessionRequest) (*sessionv1.ExamSession, error) {
 	return c.sessionClient.GetSession(ctx, req)
 }
@@ -125,6 +145,10 @@ func (c *ServiceClient) GetRemainingTime(ctx context.Context, req *sessionv1.Get
 	return c.sessionClient.GetRemainingTime(ctx, req)
 }
 
+func (c *ServiceClient) GrantExtraTime(ctx context.Context, req *sessionv1.GrantExtraTimeRequest) (*sessionv1.ExamSession, error) {
+	return c.sessionClient.GrantExtraTime(ctx, req)
+}
+
 func (c *ServiceClient) GetSessionAnswers(ctx context.Context, sessionID string) ([]*sessionv1.Answer, error) {
 	session, err := c.GetSession(ctx, &sessionv1.GetSessionRequest{
 		Id: sessionID,
@@ -176,3 +200,137 @@ func (c *ServiceClient) GetScore(ctx context.Context, req *scoringv1.GetScoreReq
 func (c *ServiceClient) ListScores(ctx context.Context, req *scoringv1.ListScoresRequest) (*scoringv1.ListScoresResponse, error) {
 	return c.scoringClient.ListScores(ctx, req)
 }
+
+func (c *ServiceClient) ListPendingGrading(ctx context.Context, req *scoringv1.ListPendingGradingRequest) (*scoringv1.ListPendingGradingResponse, error) {
+	return c.scoringClient.ListPendingGrading(ctx, req)
+}
+
+func (c *ServiceClient) RegradeExam(ctx context.Context, req *scoringv1.RegradeExamRequest) (*scoringv1.RegradeExamResponse, error) {
+	return c.scoringClient.RegradeExam(ctx, req)
+}
+
+func (c *ServiceClient) SetGradingScale(ctx context.Context, req *scoringv1.SetGradingScaleRequest) (*scoringv1.GradingScale, error) {
+	return c.scoringClient.SetGradingScale(ctx, req)
+}
+
+func (c *ServiceClient) GetGradingScale(ctx context.Context, req *scoringv1.GetGradingScaleRequest) (*scoringv1.GradingScale, error) {
+	return c.scoringClient.GetGradingScale(ctx, req)
+}
+
+func (c *ServiceClient) GetItemAnalysis(ctx context.Context, req *scoringv1.GetItemAnalysisRequest) (*scoringv1.GetItemAnalysisResponse, error) {
+	return c.scoringClient.GetItemAnalysis(ctx, req)
+}
+
+func (c *ServiceClient) ExportScores(ctx context.Context, req *scoringv1.ExportScoresRequest) (scoringv1.ScoringService_ExportScoresClient, error) {
+	return c.scoringClient.ExportScores(ctx, req)
+}
+
+func (c *ServiceClient) GetSessionReport(ctx context.Context, req *scoringv1.GetSessionReportRequest) (*scoringv1.ReportFile, error) {
+	return c.scoringClient.GetSessionReport(ctx, req)
+}
+
+func (c *ServiceClient) ExportExamReports(ctx context.Context, req *scoringv1.ExportExamReportsRequest) (scoringv1.ScoringService_ExportExamReportsClient, error) {
+	return c.scoringClient.ExportExamReports(ctx, req)
+}
+
+func (c *ServiceClient) GetExamStatistics(ctx context.Context, req *scoringv1.GetExamStatisticsRequest) (*scoringv1.ExamStatistics, error) {
+	return c.scoringClient.GetExamStatistics(ctx, req)
+}
+
+func (c *ServiceClient) AnalyzeCollusion(ctx context.Context, req *scoringv1.AnalyzeCollusionRequest) (*scoringv1.AnalyzeCollusionResponse, error) {
+	return c.scoringClient.AnalyzeCollusion(ctx, req)
+}
+
+func (c *ServiceClient) ListCollusionFlags(ctx context.Context, req *scoringv1.ListCollusionFlagsRequest) (*scoringv1.ListCollusionFlagsResponse, error) {
+	return c.scoringClient.ListCollusionFlags(ctx, req)
+}
+
+func (c *ServiceClient) ReviewCollusionFlag(ctx context.Context, req *scoringv1.ReviewCollusionFlagRequest) (*scoringv1.CollusionFlag, error) {
+	return c.scoringClient.ReviewCollusionFlag(ctx, req)
+}
+
+func (c *ServiceClient) GradeAnswer(ctx context.Context, req *scoringv1.GradeAnswerRequest) (*scoringv1.GradeAnswerResponse, error) {
+	return c.scoringClient.GradeAnswer(ctx, req)
+}
+
+// UserService methods
+func (c *ServiceClient) CreateStudent(ctx context.Context, req *userv1.CreateStudentRequest) (*userv1.Student, error) {
+	return c.userClient.CreateStudent(ctx, req)
+}
+
+func (c *ServiceClient) GetStudent(ctx context.Context, req *userv1.GetStudentRequest) (*userv1.Student, error) {
+	return c.userClient.GetStudent(ctx, req)
+}
+
+func (c *ServiceClient) ListStudents(ctx context.Context, req *userv1.ListStudentsRequest) (*userv1.ListStudentsResponse, error) {
+	return c.userClient.ListStudents(ctx, req)
+}
+
+func (c *ServiceClient) UpdateStudent(ctx context.Context, req *userv1.UpdateStudentRequest) (*userv1.Student, error) {
+	return c.userClient.UpdateStudent(ctx, req)
+}
+
+func (c *ServiceClient) DeleteStudent(ctx context.Context, req *userv1.DeleteStudentRequest) error {
+	_, err := c.userClient.DeleteStudent(ctx, req)
+	return err
+}
+
+func (c *ServiceClient) CreateTeacher(ctx context.Context, req *userv1.CreateTeacherRequest) (*userv1.Teacher, error) {
+	return c.userClient.CreateTeacher(ctx, req)
+}
+
+func (c *ServiceClient) GetTeacher(ctx context.Context, req *userv1.GetTeacherRequest) (*userv1.Teacher, error) {
+	return c.userClient.GetTeacher(ctx, req)
+}
+
+func (c *ServiceClient) ListTeachers(ctx context.Context, req *userv1.ListTeachersRequest) (*userv1.ListTeachersResponse, error) {
+	return c.userClient.ListTeachers(ctx, req)
+}
+
+func (c *ServiceClient) UpdateTeacher(ctx context.Context, req *userv1.UpdateTeacherRequest) (*userv1.Teacher, error) {
+	return c.userClient.UpdateTeacher(ctx, req)
+}
+
+func (c *ServiceClient) DeleteTeacher(ctx context.Context, req *userv1.DeleteTeacherRequest) error {
+	_, err := c.userClient.DeleteTeacher(ctx, req)
+	return err
+}
+
+func (c *ServiceClient) CreateClass(ctx context.Context, req *userv1.CreateClassRequest) (*userv1.Class, error) {
+	return c.userClient.CreateClass(ctx, req)
+}
+
+func (c *ServiceClient) GetClass(ctx context.Context, req *userv1.GetClassRequest) (*userv1.Class, error) {
+	return c.userClient.GetClass(ctx, req)
+}
+
+func (c *ServiceClient) ListClasses(ctx context.Context, req *userv1.ListClassesRequest) (*userv1.ListClassesResponse, error) {
+	return c.userClient.ListClasses(ctx, req)
+}
+
+func (c *ServiceClient) UpdateClass(ctx context.Context, req *userv1.UpdateClassRequest) (*userv1.Class, error) {
+	return c.userClient.UpdateClass(ctx, req)
+}
+
+func (c *ServiceClient) DeleteClass(ctx context.Context, req *userv1.DeleteClassRequest) error {
+	_, err := c.userClient.DeleteClass(ctx, req)
+	return err
+}
+
+func (c *ServiceClient) EnrollStudents(ctx context.Context, req *userv1.EnrollStudentsRequest) error {
+	_, err := c.userClient.EnrollStudents(ctx, req)
+	return err
+}
+
+func (c *ServiceClient) UnenrollStudent(ctx context.Context, req *userv1.UnenrollStudentRequest) error {
+	_, err := c.userClient.UnenrollStudent(ctx, req)
+	return err
+}
+
+func (c *ServiceClient) ListClassStudents(ctx context.Context, req *userv1.ListClassStudentsRequest) (*userv1.ListClassStudentsResponse, error) {
+	return c.userClient.ListClassStudents(ctx, req)
+}
+
+func (c *ServiceClient) ImportStudents(ctx context.Context, req *userv1.ImportStudentsRequest) (*userv1.ImportStudentsResponse, error) {
+	return c.userClient.ImportStudents(ctx, req)
+}
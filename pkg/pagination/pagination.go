@@ -0,0 +1,68 @@
+// Package pagination membuat dan membaca page token berbasis cursor (keyset).
+// Token berisi posisi baris terakhir pada halaman sebelumnya, dikodekan base64
+// agar klien memperlakukannya sebagai nilai buram.
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"time"
+)
+
+const (
+	DefaultPageSize = 10
+	MaxPageSize     = 100
+)
+
+// ErrInvalidToken dikembalikan untuk page token yang tidak dibuat oleh paket ini
+var ErrInvalidToken = errors.New("invalid page token")
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// Cursor adalah kunci urutan baris terakhir yang sudah dikirim. Time atau Value
+// diisi sesuai kolom urutan query; ID memecah urutan yang sama. Order mencatat
+// urutan yang dipakai jika RPC mendukung beberapa urutan.
+type Cursor struct {
+	Time  time.Time `json:"t,omitempty"`
+	Value string    `json:"v,omitempty"`
+	ID    string    `json:"id"`
+	Order string    `json:"o,omitempty"`
+}
+
+// Encode mengubah cursor menjadi page token
+func Encode(c Cursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decode membaca page token; token kosong berarti halaman pertama (nil)
+func Decode(token string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil || !uuidPattern.MatchString(c.ID) {
+		return nil, ErrInvalidToken
+	}
+
+	return &c, nil
+}
+
+// PageSize menerapkan ukuran halaman default dan batas maksimal
+func PageSize(size int32) int32 {
+	if size <= 0 {
+		return DefaultPageSize
+	}
+	if size > MaxPageSize {
+		return MaxPageSize
+	}
+	return size
+}
@@ -0,0 +1,64 @@
+// Package shuffle menghasilkan urutan pilihan jawaban yang deterministik per sesi.
+// QuestionService memakainya untuk menampilkan pilihan, SessionService untuk
+// mengembalikan huruf yang dipilih siswa ke huruf kanonik soal.
+package shuffle
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"strings"
+)
+
+// Permutation mengembalikan perm dengan perm[i] adalah indeks kanonik dari pilihan
+// yang ditampilkan pada posisi i. Seed 0 berarti pilihan tidak diacak.
+func Permutation(seed int64, questionID string, n int) []int {
+	if seed == 0 {
+		perm := make([]int, n)
+		for i := range perm {
+			perm[i] = i
+		}
+		return perm
+	}
+
+	h := fnv.New64a()
+	h.Write([]byte(questionID))
+	return rand.New(rand.NewSource(seed ^ int64(h.Sum64()))).Perm(n)
+}
+
+// ToCanonical mengubah huruf pilihan yang ditampilkan ke siswa menjadi huruf kanonik
+func ToCanonical(seed int64, questionID string, n int, displayed string) (string, error) {
+	idx, ok := Index(displayed)
+	if !ok || idx >= n {
+		return "", fmt.Errorf("invalid choice %q", displayed)
+	}
+	return Letter(Permutation(seed, questionID, n)[idx]), nil
+}
+
+// ToDisplayed mengubah huruf kanonik menjadi huruf yang ditampilkan ke siswa
+func ToDisplayed(seed int64, questionID string, n int, canonical string) (string, error) {
+	idx, ok := Index(canonical)
+	if !ok || idx >= n {
+		return "", fmt.Errorf("invalid choice %q", canonical)
+	}
+	for displayed, c := range Permutation(seed, questionID, n) {
+		if c == idx {
+			return Letter(displayed), nil
+		}
+	}
+	return "", fmt.Errorf("invalid choice %q", canonical)
+}
+
+// Letter mengubah indeks pilihan (0, 1, ...) menjadi huruf (A, B, ...)
+func Letter(i int) string {
+	return string(rune('A' + i))
+}
+
+// Index mengubah huruf pilihan menjadi indeks, tidak membedakan huruf besar/kecil
+func Index(letter string) (int, bool) {
+	letter = strings.ToUpper(strings.TrimSpace(letter))
+	if len(letter) != 1 || letter[0] < 'A' || letter[0] > 'Z' {
+		return 0, false
+	}
+	return int(letter[0] - 'A'), true
+}
@@ -20,6 +20,11 @@ protoc --go_out=. --go_opt=paths=source_relative ^
 @REM protoc --go_out=. --go_opt=paths=source_relative ^
 @REM     --go-grpc_out=. --go-grpc_opt=paths=source_relative ^
 @REM     api/proto/scoring/v1/scoring.proto
+@REM
+@REM REM Generate user service
+@REM protoc --go_out=. --go_opt=paths=source_relative ^
+@REM     --go-grpc_out=. --go-grpc_opt=paths=source_relative ^
+@REM     api/proto/user/v1/user.proto
 
 echo Protocol buffer code generation completed.
 endlocal
\ No newline at end of file